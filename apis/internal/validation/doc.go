@@ -0,0 +1,22 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation is the version independent implementation of the
+// validation of Gateway API objects. It operates on the v1 types, which the
+// v1beta1 and v1alpha2 types either alias or are convertible to, so that
+// objects of every API version are validated identically. The versioned
+// validation packages are thin adapters over this package.
+package validation // import "sigs.k8s.io/gateway-api/apis/internal/validation"
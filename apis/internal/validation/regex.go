@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp/syntax"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ValidateHTTPRouteRegularExpressions validates the RegularExpression path,
+// header and query param matches of an HTTPRoute spec. See
+// ValidateRegularExpression for the performed checks.
+func ValidateHTTPRouteRegularExpressions(spec *gatewayv1.HTTPRouteSpec, path *field.Path) (field.ErrorList, []string) {
+	var errs field.ErrorList
+	var warnings []string
+	add := func(e field.ErrorList, w []string) {
+		errs = append(errs, e...)
+		warnings = append(warnings, w...)
+	}
+
+	for i, rule := range spec.Rules {
+		for j, match := range rule.Matches {
+			matchPath := path.Child("rules").Index(i).Child("matches").Index(j)
+			if match.Path != nil && match.Path.Type != nil && *match.Path.Type == gatewayv1.PathMatchRegularExpression && match.Path.Value != nil {
+				add(ValidateRegularExpression(*match.Path.Value, matchPath.Child("path", "value")))
+			}
+			for k, header := range match.Headers {
+				if header.Type != nil && *header.Type == gatewayv1.HeaderMatchRegularExpression {
+					add(ValidateRegularExpression(header.Value, matchPath.Child("headers").Index(k).Child("value")))
+				}
+			}
+			for k, param := range match.QueryParams {
+				if param.Type != nil && *param.Type == gatewayv1.QueryParamMatchRegularExpression {
+					add(ValidateRegularExpression(param.Value, matchPath.Child("queryParams").Index(k).Child("value")))
+				}
+			}
+		}
+	}
+	return errs, warnings
+}
+
+// ValidateRegularExpression parses pattern with the RE2 syntax of the Go
+// regexp package. Syntax errors and constructs RE2 does not support, such as
+// backreferences and lookarounds, are returned as errors. Patterns that are
+// valid but likely to be ambiguous or expensive in the regular expression
+// engines used by implementations are returned as warnings: unanchored
+// patterns, nested quantifiers and repeated alternations.
+func ValidateRegularExpression(pattern string, path *field.Path) (field.ErrorList, []string) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, pattern, fmt.Sprintf("must be a valid RE2 regular expression: %v", err))}, nil
+	}
+
+	var warnings []string
+	warn := func(msg string) {
+		warnings = append(warnings, fmt.Sprintf("%s: regular expression %q %s", path, pattern, msg))
+	}
+	if !isAnchoredStart(re) || !isAnchoredEnd(re) {
+		warn("is not anchored with ^ and $, implementations may match it against a substring of the value")
+	}
+	nested, alternation := inspectRepetitions(re, false)
+	if nested {
+		warn("contains nested quantifiers, e.g. (a+)+, which may be expensive for backtracking regular expression engines")
+	}
+	if alternation {
+		warn("repeats an alternation, e.g. (a|ab)*, which may be ambiguous and expensive for backtracking regular expression engines")
+	}
+	return nil, warnings
+}
+
+func isAnchoredStart(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpBeginText, syntax.OpBeginLine:
+		return true
+	case syntax.OpConcat, syntax.OpCapture:
+		return len(re.Sub) > 0 && isAnchoredStart(re.Sub[0])
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if !isAnchoredStart(sub) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func isAnchoredEnd(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpEndText, syntax.OpEndLine:
+		return true
+	case syntax.OpConcat, syntax.OpCapture:
+		return len(re.Sub) > 0 && isAnchoredEnd(re.Sub[len(re.Sub)-1])
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if !isAnchoredEnd(sub) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// inspectRepetitions reports whether re contains an unbounded repetition
+// nested in another one, and whether it contains an alternation nested in an
+// unbounded repetition. repeated is true when re is itself nested in an
+// unbounded repetition.
+func inspectRepetitions(re *syntax.Regexp, repeated bool) (nested, alternation bool) {
+	unbounded := re.Op == syntax.OpStar || re.Op == syntax.OpPlus || (re.Op == syntax.OpRepeat && re.Max == -1)
+	if unbounded && repeated {
+		nested = true
+	}
+	if re.Op == syntax.OpAlternate && repeated {
+		alternation = true
+	}
+	for _, sub := range re.Sub {
+		n, a := inspectRepetitions(sub, repeated || unbounded)
+		nested = nested || n
+		alternation = alternation || a
+	}
+	return nested, alternation
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gatewayvalidation "sigs.k8s.io/gateway-api/apis/internal/validation"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestValidateRegularExpression(t *testing.T) {
+	const (
+		unanchored  = "is not anchored with ^ and $, implementations may match it against a substring of the value"
+		nested      = "contains nested quantifiers, e.g. (a+)+, which may be expensive for backtracking regular expression engines"
+		alternation = "repeats an alternation, e.g. (a|ab)*, which may be ambiguous and expensive for backtracking regular expression engines"
+	)
+
+	tests := []struct {
+		pattern      string
+		wantErr      string
+		wantWarnings []string
+	}{
+		{pattern: `^/foo/[0-9]+$`},
+		{pattern: `^/(foo|bar)/.*$`},
+		{pattern: `^/foo$|^/bar$`},
+		{pattern: `^(/foo|/bar)$`},
+		{pattern: `/foo/[0-9]+`, wantWarnings: []string{unanchored}},
+		{pattern: `^/foo`, wantWarnings: []string{unanchored}},
+		{pattern: `^/foo$|/bar`, wantWarnings: []string{unanchored}},
+		{pattern: `^(a+)+$`, wantWarnings: []string{nested}},
+		{pattern: `^(x*y)*$`, wantWarnings: []string{nested}},
+		{pattern: `^(a{2,})*$`, wantWarnings: []string{nested}},
+		{pattern: `^(a{1,3})*$`},
+		{pattern: `^(foo|foobar)*$`, wantWarnings: []string{alternation}},
+		{pattern: `(\w+|\d+)+`, wantWarnings: []string{unanchored, nested, alternation}},
+		{pattern: `^/foo/(?=bar)$`, wantErr: "must be a valid RE2 regular expression: error parsing regexp: invalid or unsupported Perl syntax: `(?=`"},
+		{pattern: `^(a)\1$`, wantErr: "must be a valid RE2 regular expression: error parsing regexp: invalid escape sequence: `\\1`"},
+		{pattern: `^/foo[$`, wantErr: "must be a valid RE2 regular expression: error parsing regexp: missing closing ]: `[$`"},
+		{pattern: `^a{1001}$`, wantErr: "must be a valid RE2 regular expression: error parsing regexp: invalid repeat count: `{1001}`"},
+	}
+
+	path := field.NewPath("value")
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.pattern, func(t *testing.T) {
+			errs, warnings := gatewayvalidation.ValidateRegularExpression(tc.pattern, path)
+			if tc.wantErr == "" {
+				assert.Empty(t, errs)
+			} else if assert.Len(t, errs, 1) {
+				assert.Equal(t, tc.wantErr, errs[0].Detail)
+				assert.Empty(t, warnings)
+			}
+
+			var want []string
+			for _, w := range tc.wantWarnings {
+				want = append(want, fmt.Sprintf("value: regular expression %q %s", tc.pattern, w))
+			}
+			assert.Equal(t, want, warnings)
+		})
+	}
+}
+
+func TestValidateHTTPRouteRegularExpressions(t *testing.T) {
+	regex := gatewayv1.PathMatchRegularExpression
+	headerRegex := gatewayv1.HeaderMatchRegularExpression
+	headerExact := gatewayv1.HeaderMatchExact
+	queryRegex := gatewayv1.QueryParamMatchRegularExpression
+	prefix := gatewayv1.PathMatchPathPrefix
+
+	spec := &gatewayv1.HTTPRouteSpec{
+		Rules: []gatewayv1.HTTPRouteRule{{
+			Matches: []gatewayv1.HTTPRouteMatch{{
+				Path: &gatewayv1.HTTPPathMatch{Type: &prefix, Value: ptrTo("/(")},
+			}, {
+				Path: &gatewayv1.HTTPPathMatch{Type: &regex, Value: ptrTo("^/v[0-9]+$")},
+				Headers: []gatewayv1.HTTPHeaderMatch{
+					{Type: &headerExact, Name: "exact", Value: "("},
+					{Type: &headerRegex, Name: "version", Value: "^v(1|2$"},
+				},
+				QueryParams: []gatewayv1.HTTPQueryParamMatch{
+					{Type: &queryRegex, Name: "q", Value: "foo"},
+				},
+			}},
+		}},
+	}
+
+	errs, warnings := gatewayvalidation.ValidateHTTPRouteRegularExpressions(spec, field.NewPath("spec"))
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "spec.rules[0].matches[1].headers[1].value", errs[0].Field)
+	}
+	assert.Equal(t, []string{
+		`spec.rules[0].matches[1].queryParams[0].value: regular expression "foo" is not anchored with ^ and $, implementations may match it against a substring of the value`,
+	}, warnings)
+}
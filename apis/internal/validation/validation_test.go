@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1validation "sigs.k8s.io/gateway-api/apis/v1/validation"
+	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1a2validation "sigs.k8s.io/gateway-api/apis/v1alpha2/validation"
+	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatewayv1b1validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
+)
+
+// TestVersionsValidatedIdentically ensures that the versioned validation
+// packages agree on the same objects.
+func TestVersionsValidatedIdentically(t *testing.T) {
+	hostname := gatewayv1.Hostname("foo.com")
+	gateway := gatewayv1.Gateway{
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{{
+				Name:     "tcp",
+				Protocol: gatewayv1.TCPProtocolType,
+				Port:     80,
+				Hostname: &hostname,
+			}, {
+				Name:     "tcp",
+				Protocol: gatewayv1.HTTPSProtocolType,
+				Port:     443,
+			}},
+			Addresses: []gatewayv1.GatewayAddress{{
+				Type:  ptrTo(gatewayv1.IPAddressType),
+				Value: "1.2.3.4.5",
+			}},
+		},
+	}
+	gatewayErrs := gatewayv1validation.ValidateGateway(&gateway)
+	require.NotEmpty(t, gatewayErrs)
+	assert.Equal(t, gatewayErrs, gatewayv1b1validation.ValidateGateway((*gatewayv1b1.Gateway)(&gateway)))
+	assert.Equal(t, gatewayErrs, gatewayv1a2validation.ValidateGateway((*gatewayv1a2.Gateway)(&gateway)))
+
+	route := gatewayv1.HTTPRoute{
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw"}, {Name: "gw"}},
+			},
+			Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  ptrTo(gatewayv1.PathMatchExact),
+						Value: ptrTo("/foo/../bar"),
+					},
+				}},
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				}},
+				BackendRefs: []gatewayv1.HTTPBackendRef{{
+					BackendRef: gatewayv1.BackendRef{
+						BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc"},
+					},
+				}},
+			}},
+		},
+	}
+	routeErrs := gatewayv1validation.ValidateHTTPRoute(&route)
+	require.NotEmpty(t, routeErrs)
+	assert.Equal(t, routeErrs, gatewayv1b1validation.ValidateHTTPRoute((*gatewayv1b1.HTTPRoute)(&route)))
+	assert.Equal(t, routeErrs, gatewayv1a2validation.ValidateHTTPRoute((*gatewayv1a2.HTTPRoute)(&route)))
+
+	oldClass := gatewayv1.GatewayClass{Spec: gatewayv1.GatewayClassSpec{ControllerName: "example.com/foo"}}
+	newClass := gatewayv1.GatewayClass{Spec: gatewayv1.GatewayClassSpec{ControllerName: "example.com/bar"}}
+	classErrs := gatewayv1validation.ValidateGatewayClassUpdate(&oldClass, &newClass)
+	require.NotEmpty(t, classErrs)
+	assert.Equal(t, classErrs, gatewayv1b1validation.ValidateGatewayClassUpdate((*gatewayv1b1.GatewayClass)(&oldClass), (*gatewayv1b1.GatewayClass)(&newClass)))
+	assert.Equal(t, classErrs, gatewayv1a2validation.ValidateGatewayClassUpdate((*gatewayv1a2.GatewayClass)(&oldClass), (*gatewayv1a2.GatewayClass)(&newClass)))
+}
+
+func ptrTo[T any](a T) *T {
+	return &a
+}
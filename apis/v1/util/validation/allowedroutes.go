@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// DefaultRouteKinds returns the kinds of Routes defined by the Gateway API
+// that are compatible with the protocol of a Listener. They are the kinds
+// allowed when AllowedRoutes does not list any. Implementations may support
+// only a subset of them. Nil is returned for implementation specific
+// protocols.
+func DefaultRouteKinds(listener *gatewayv1.Listener) []gatewayv1.RouteGroupKind {
+	switch listener.Protocol {
+	case gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType:
+		return routeGroupKinds("HTTPRoute", "GRPCRoute")
+	case gatewayv1.TLSProtocolType:
+		if listener.TLS != nil && (listener.TLS.Mode == nil || *listener.TLS.Mode == gatewayv1.TLSModeTerminate) {
+			return routeGroupKinds("TCPRoute")
+		}
+		return routeGroupKinds("TLSRoute")
+	case gatewayv1.TCPProtocolType:
+		return routeGroupKinds("TCPRoute")
+	case gatewayv1.UDPProtocolType:
+		return routeGroupKinds("UDPRoute")
+	}
+	return nil
+}
+
+// RouteKindAllowed reports whether Routes of the given kind may be attached
+// to a Listener. When AllowedRoutes does not list any kinds, the kinds
+// returned by DefaultRouteKinds are allowed. A nil group is defaulted to
+// gateway.networking.k8s.io, as done by the API server.
+func RouteKindAllowed(listener *gatewayv1.Listener, kind gatewayv1.RouteGroupKind) bool {
+	kinds := DefaultRouteKinds(listener)
+	if listener.AllowedRoutes != nil && len(listener.AllowedRoutes.Kinds) > 0 {
+		kinds = listener.AllowedRoutes.Kinds
+	}
+	for _, k := range kinds {
+		if k.Kind == kind.Kind && routeGroup(k.Group) == routeGroup(kind.Group) {
+			return true
+		}
+	}
+	return false
+}
+
+// RouteNamespaceAllowed reports whether Routes in the namespace with the
+// given name and labels may be attached to a Listener of a Gateway in
+// gatewayNamespace. When AllowedRoutes does not specify namespaces, only
+// Routes in the namespace of the Gateway are allowed. An error is returned
+// if the namespace selector is invalid.
+func RouteNamespaceAllowed(listener *gatewayv1.Listener, gatewayNamespace, routeNamespace string, routeNamespaceLabels map[string]string) (bool, error) {
+	from := gatewayv1.NamespacesFromSame
+	var selector *metav1.LabelSelector
+	if listener.AllowedRoutes != nil && listener.AllowedRoutes.Namespaces != nil {
+		if listener.AllowedRoutes.Namespaces.From != nil {
+			from = *listener.AllowedRoutes.Namespaces.From
+		}
+		selector = listener.AllowedRoutes.Namespaces.Selector
+	}
+
+	switch from {
+	case gatewayv1.NamespacesFromAll:
+		return true, nil
+	case gatewayv1.NamespacesFromSame:
+		return routeNamespace == gatewayNamespace, nil
+	case gatewayv1.NamespacesFromSelector:
+		if selector == nil {
+			return false, fmt.Errorf("namespace selector must be set when from is %s", from)
+		}
+		s, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false, fmt.Errorf("invalid namespace selector: %w", err)
+		}
+		return s.Matches(labels.Set(routeNamespaceLabels)), nil
+	}
+	return false, fmt.Errorf("unsupported value for from: %s", from)
+}
+
+func routeGroupKinds(kinds ...gatewayv1.Kind) []gatewayv1.RouteGroupKind {
+	group := gatewayv1.Group(gatewayv1.GroupName)
+	out := make([]gatewayv1.RouteGroupKind, 0, len(kinds))
+	for _, kind := range kinds {
+		out = append(out, gatewayv1.RouteGroupKind{Group: &group, Kind: kind})
+	}
+	return out
+}
+
+func routeGroup(group *gatewayv1.Group) gatewayv1.Group {
+	if group == nil {
+		return gatewayv1.GroupName
+	}
+	return *group
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1/util/validation"
+)
+
+func TestRouteKindAllowed(t *testing.T) {
+	gatewayGroup := gatewayv1.Group(gatewayv1.GroupName)
+	otherGroup := gatewayv1.Group("example.com")
+	passthrough := gatewayv1.TLSModePassthrough
+
+	testCases := []struct {
+		name     string
+		listener gatewayv1.Listener
+		allowed  []gatewayv1.Kind
+		denied   []gatewayv1.Kind
+	}{{
+		name:     "HTTP listener defaults",
+		listener: gatewayv1.Listener{Protocol: gatewayv1.HTTPProtocolType},
+		allowed:  []gatewayv1.Kind{"HTTPRoute", "GRPCRoute"},
+		denied:   []gatewayv1.Kind{"TLSRoute", "TCPRoute"},
+	}, {
+		name:     "TLS passthrough listener defaults",
+		listener: gatewayv1.Listener{Protocol: gatewayv1.TLSProtocolType, TLS: &gatewayv1.GatewayTLSConfig{Mode: &passthrough}},
+		allowed:  []gatewayv1.Kind{"TLSRoute"},
+		denied:   []gatewayv1.Kind{"TCPRoute", "HTTPRoute"},
+	}, {
+		name:     "TLS terminate listener defaults",
+		listener: gatewayv1.Listener{Protocol: gatewayv1.TLSProtocolType, TLS: &gatewayv1.GatewayTLSConfig{}},
+		allowed:  []gatewayv1.Kind{"TCPRoute"},
+		denied:   []gatewayv1.Kind{"TLSRoute"},
+	}, {
+		name:     "UDP listener defaults",
+		listener: gatewayv1.Listener{Protocol: gatewayv1.UDPProtocolType},
+		allowed:  []gatewayv1.Kind{"UDPRoute"},
+		denied:   []gatewayv1.Kind{"TCPRoute"},
+	}, {
+		name:     "implementation specific protocol",
+		listener: gatewayv1.Listener{Protocol: "example.com/custom"},
+		denied:   []gatewayv1.Kind{"HTTPRoute"},
+	}, {
+		name: "explicit kinds override the defaults",
+		listener: gatewayv1.Listener{
+			Protocol: gatewayv1.HTTPProtocolType,
+			AllowedRoutes: &gatewayv1.AllowedRoutes{
+				Kinds: []gatewayv1.RouteGroupKind{{Group: &gatewayGroup, Kind: "GRPCRoute"}},
+			},
+		},
+		allowed: []gatewayv1.Kind{"GRPCRoute"},
+		denied:  []gatewayv1.Kind{"HTTPRoute"},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			for _, kind := range tc.allowed {
+				assert.True(t, validationutils.RouteKindAllowed(&tc.listener, gatewayv1.RouteGroupKind{Kind: kind}), "kind %s must be allowed", kind)
+				assert.False(t, validationutils.RouteKindAllowed(&tc.listener, gatewayv1.RouteGroupKind{Group: &otherGroup, Kind: kind}), "kind %s of another group must not be allowed", kind)
+			}
+			for _, kind := range tc.denied {
+				assert.False(t, validationutils.RouteKindAllowed(&tc.listener, gatewayv1.RouteGroupKind{Kind: kind}), "kind %s must not be allowed", kind)
+			}
+		})
+	}
+}
+
+func TestRouteNamespaceAllowed(t *testing.T) {
+	all := gatewayv1.NamespacesFromAll
+	same := gatewayv1.NamespacesFromSame
+	selector := gatewayv1.NamespacesFromSelector
+	invalid := gatewayv1.FromNamespaces("Invalid")
+
+	listener := func(from *gatewayv1.FromNamespaces, s *metav1.LabelSelector) *gatewayv1.Listener {
+		return &gatewayv1.Listener{
+			AllowedRoutes: &gatewayv1.AllowedRoutes{
+				Namespaces: &gatewayv1.RouteNamespaces{From: from, Selector: s},
+			},
+		}
+	}
+	teamSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+
+	testCases := []struct {
+		name           string
+		listener       *gatewayv1.Listener
+		routeNamespace string
+		labels         map[string]string
+		want           bool
+		wantErr        string
+	}{{
+		name:           "defaults to same namespace",
+		listener:       &gatewayv1.Listener{},
+		routeNamespace: "gateway",
+		want:           true,
+	}, {
+		name:           "defaults to same namespace, other namespace",
+		listener:       &gatewayv1.Listener{},
+		routeNamespace: "other",
+		want:           false,
+	}, {
+		name:           "same namespace",
+		listener:       listener(&same, nil),
+		routeNamespace: "other",
+		want:           false,
+	}, {
+		name:           "all namespaces",
+		listener:       listener(&all, nil),
+		routeNamespace: "other",
+		want:           true,
+	}, {
+		name:           "selector matches",
+		listener:       listener(&selector, teamSelector),
+		routeNamespace: "other",
+		labels:         map[string]string{"team": "a"},
+		want:           true,
+	}, {
+		name:           "selector does not match",
+		listener:       listener(&selector, teamSelector),
+		routeNamespace: "gateway",
+		labels:         map[string]string{"team": "b"},
+		want:           false,
+	}, {
+		name:           "missing selector",
+		listener:       listener(&selector, nil),
+		routeNamespace: "gateway",
+		wantErr:        "namespace selector must be set when from is Selector",
+	}, {
+		name: "invalid selector",
+		listener: listener(&selector, &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key: "team", Operator: "Matches",
+		}}}),
+		routeNamespace: "gateway",
+		wantErr:        `invalid namespace selector: "Matches" is not a valid label selector operator`,
+	}, {
+		name:           "unsupported from",
+		listener:       listener(&invalid, nil),
+		routeNamespace: "gateway",
+		wantErr:        "unsupported value for from: Invalid",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := validationutils.RouteNamespaceAllowed(tc.listener, "gateway", tc.routeNamespace, tc.labels)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
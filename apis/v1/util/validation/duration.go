@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+var durationRegex = regexp.MustCompile(`^([0-9]{1,5}(h|m|s|ms)){1,4}$`)
+
+// MaxDuration is the longest duration FormatDuration can render in the
+// standard GEP-2257 form, 99999h59m59s999ms.
+const MaxDuration = 99999*time.Hour + 59*time.Minute + 59*time.Second + 999*time.Millisecond
+
+// ParseDuration parses a GEP-2257 Duration: one to four components, each
+// made of a decimal integer of one to five digits followed by one of the
+// units h, m, s or ms. The total duration is the sum of all components.
+// Values accepted by time.ParseDuration but not by GEP-2257, such as "1.5s",
+// "-1s" or "1d", are rejected.
+func ParseDuration(d gatewayv1.Duration) (time.Duration, error) {
+	if !durationRegex.MatchString(string(d)) {
+		return 0, fmt.Errorf("invalid duration %q: must be one to four components of 1 to 5 digits followed by h, m, s or ms, e.g. 1h30m", d)
+	}
+	return time.ParseDuration(string(d))
+}
+
+// FormatDuration formats a duration in the standard GEP-2257 form, using
+// descending, non repeating units with the largest unit possible for each
+// component, e.g. "1h30m" rather than "90m". The zero duration is rendered
+// as "0s". Negative durations, durations longer than MaxDuration and
+// durations that are not a whole number of milliseconds cannot be
+// represented.
+func FormatDuration(d time.Duration) (gatewayv1.Duration, error) {
+	switch {
+	case d < 0:
+		return "", fmt.Errorf("invalid duration %s: must not be negative", d)
+	case d > MaxDuration:
+		return "", fmt.Errorf("invalid duration %s: must not be longer than %s", d, MaxDuration)
+	case d%time.Millisecond != 0:
+		return "", fmt.Errorf("invalid duration %s: must be a whole number of milliseconds", d)
+	case d == 0:
+		return "0s", nil
+	}
+
+	var b strings.Builder
+	for _, unit := range []struct {
+		suffix   string
+		duration time.Duration
+	}{
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+		{"ms", time.Millisecond},
+	} {
+		if n := d / unit.duration; n > 0 {
+			b.WriteString(strconv.FormatInt(int64(n), 10))
+			b.WriteString(unit.suffix)
+			d -= n * unit.duration
+		}
+	}
+	return gatewayv1.Duration(b.String()), nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1/util/validation"
+)
+
+func TestParseDuration(t *testing.T) {
+	testCases := []struct {
+		duration gatewayv1.Duration
+		want     time.Duration
+		wantErr  bool
+	}{
+		{duration: "0s", want: 0},
+		{duration: "0h", want: 0},
+		{duration: "1h", want: time.Hour},
+		{duration: "60m", want: time.Hour},
+		{duration: "01h", want: time.Hour},
+		{duration: "00060m", want: time.Hour},
+		{duration: "1h30m30s500ms", want: time.Hour + 30*time.Minute + 30*time.Second + 500*time.Millisecond},
+		{duration: "1s500ms", want: 1500 * time.Millisecond},
+		{duration: "1h500ms", want: time.Hour + 500*time.Millisecond},
+		{duration: "30m1h", want: 90 * time.Minute},
+		{duration: "1h2h20m10m", want: 3*time.Hour + 30*time.Minute},
+		{duration: "99999h", want: 99999 * time.Hour},
+		{duration: "", wantErr: true},
+		{duration: "0", wantErr: true},
+		{duration: "1.5s", wantErr: true},
+		{duration: "-1s", wantErr: true},
+		{duration: "+1s", wantErr: true},
+		{duration: "1d", wantErr: true},
+		{duration: "1us", wantErr: true},
+		{duration: "100000s", wantErr: true},
+		{duration: "1h1m1s1ms1h", wantErr: true},
+		{duration: "1h ", wantErr: true},
+		{duration: "1H", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(string(tc.duration), func(t *testing.T) {
+			got, err := validationutils.ParseDuration(tc.duration)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	testCases := []struct {
+		duration time.Duration
+		want     gatewayv1.Duration
+		wantErr  string
+	}{
+		{duration: 0, want: "0s"},
+		{duration: time.Hour, want: "1h"},
+		{duration: 90 * time.Minute, want: "1h30m"},
+		{duration: 1500 * time.Millisecond, want: "1s500ms"},
+		{duration: time.Hour + 500*time.Millisecond, want: "1h500ms"},
+		{duration: 100 * time.Hour, want: "100h"},
+		{duration: validationutils.MaxDuration, want: "99999h59m59s999ms"},
+		{duration: -time.Second, wantErr: "invalid duration -1s: must not be negative"},
+		{duration: time.Microsecond, wantErr: "invalid duration 1µs: must be a whole number of milliseconds"},
+		{duration: 100000 * time.Hour, wantErr: "invalid duration 100000h0m0s: must not be longer than 99999h59m59.999s"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.duration.String(), func(t *testing.T) {
+			got, err := validationutils.FormatDuration(tc.duration)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+
+			parsed, err := validationutils.ParseDuration(got)
+			require.NoError(t, err)
+			assert.Equal(t, tc.duration, parsed)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// IntersectHostnames computes the hostnames a route serves when attached to
+// a Listener, following the rules documented on HTTPRoute and TLSRoute
+// hostnames. Route hostnames that do not intersect with the Listener hostname
+// are dropped, and wildcard route hostnames are narrowed down to a precise
+// Listener hostname.
+//
+// The returned bool reports whether the route is compatible with the
+// Listener. An empty list together with true means that neither the Listener
+// nor the route restrict hostnames, so every hostname matches.
+func IntersectHostnames(listenerHostname *gatewayv1.Hostname, routeHostnames []gatewayv1.Hostname) ([]gatewayv1.Hostname, bool) {
+	if listenerHostname == nil || *listenerHostname == "" {
+		return dedupHostnames(routeHostnames), true
+	}
+	if len(routeHostnames) == 0 {
+		return []gatewayv1.Hostname{*listenerHostname}, true
+	}
+
+	var intersection []gatewayv1.Hostname
+	for _, hostname := range routeHostnames {
+		if h, ok := HostnameIntersection(*listenerHostname, hostname); ok {
+			intersection = append(intersection, h)
+		}
+	}
+	intersection = dedupHostnames(intersection)
+	return intersection, len(intersection) > 0
+}
+
+// HostnameIntersection returns the most specific hostname matched by both a
+// and b, which may each be precise or prefixed with a wildcard label. For
+// example, the intersection of "*.example.com" and "foo.example.com" is
+// "foo.example.com", while "*.example.com" and "example.com" do not
+// intersect.
+func HostnameIntersection(a, b gatewayv1.Hostname) (gatewayv1.Hostname, bool) {
+	switch {
+	case a == b:
+		return a, true
+	case isWildcardHostname(a) && matchesWildcardHostname(a, b):
+		return b, true
+	case isWildcardHostname(b) && matchesWildcardHostname(b, a):
+		return a, true
+	}
+	return "", false
+}
+
+// HostnameMatches reports whether a precise hostname, such as the host of a
+// request, is matched by a precise or wildcard hostname.
+func HostnameMatches(hostname gatewayv1.Hostname, host string) bool {
+	if isWildcardHostname(hostname) {
+		return matchesWildcardHostname(hostname, gatewayv1.Hostname(host))
+	}
+	return string(hostname) == host
+}
+
+func isWildcardHostname(hostname gatewayv1.Hostname) bool {
+	return strings.HasPrefix(string(hostname), "*.")
+}
+
+// matchesWildcardHostname reports whether hostname, precise or wildcard, is
+// covered by wildcard. A wildcard label matches one or more labels, so
+// "*.example.com" covers "a.b.example.com" and "*.b.example.com" but not
+// "example.com".
+func matchesWildcardHostname(wildcard, hostname gatewayv1.Hostname) bool {
+	suffix := string(wildcard[1:])
+	return len(hostname) > len(suffix) && strings.HasSuffix(string(hostname), suffix)
+}
+
+func dedupHostnames(hostnames []gatewayv1.Hostname) []gatewayv1.Hostname {
+	if len(hostnames) == 0 {
+		return nil
+	}
+	seen := make(map[gatewayv1.Hostname]bool, len(hostnames))
+	out := make([]gatewayv1.Hostname, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		if !seen[hostname] {
+			seen[hostname] = true
+			out = append(out, hostname)
+		}
+	}
+	return out
+}
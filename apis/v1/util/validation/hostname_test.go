@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1/util/validation"
+)
+
+func TestIntersectHostnames(t *testing.T) {
+	testCases := []struct {
+		name             string
+		listenerHostname *gatewayv1.Hostname
+		routeHostnames   []gatewayv1.Hostname
+		want             []gatewayv1.Hostname
+		wantOK           bool
+	}{{
+		name:   "neither listener nor route specify hostnames",
+		wantOK: true,
+	}, {
+		name:             "empty listener hostname matches everything",
+		listenerHostname: hostname(""),
+		routeHostnames:   []gatewayv1.Hostname{"foo.example.com", "*.example.net"},
+		want:             []gatewayv1.Hostname{"foo.example.com", "*.example.net"},
+		wantOK:           true,
+	}, {
+		name:             "route without hostnames inherits the listener hostname",
+		listenerHostname: hostname("*.example.com"),
+		want:             []gatewayv1.Hostname{"*.example.com"},
+		wantOK:           true,
+	}, {
+		name:             "precise listener hostname matches precise and wildcard route hostnames",
+		listenerHostname: hostname("test.example.com"),
+		routeHostnames:   []gatewayv1.Hostname{"test.example.com", "*.example.com", "other.example.com"},
+		want:             []gatewayv1.Hostname{"test.example.com"},
+		wantOK:           true,
+	}, {
+		name:             "wildcard listener hostname keeps matching route hostnames only",
+		listenerHostname: hostname("*.example.com"),
+		routeHostnames:   []gatewayv1.Hostname{"test.example.com", "foo.test.example.com", "example.com", "test.example.net", "*.example.com", "*.test.example.com", "*.com"},
+		want:             []gatewayv1.Hostname{"test.example.com", "foo.test.example.com", "*.example.com", "*.test.example.com"},
+		wantOK:           true,
+	}, {
+		name:             "wildcard route hostname does not match the parent domain",
+		listenerHostname: hostname("example.com"),
+		routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
+		wantOK:           false,
+	}, {
+		name:             "no intersection",
+		listenerHostname: hostname("*.example.com"),
+		routeHostnames:   []gatewayv1.Hostname{"test.example.net", "example.com"},
+		wantOK:           false,
+	}, {
+		name:           "duplicate route hostnames are removed",
+		routeHostnames: []gatewayv1.Hostname{"foo.example.com", "foo.example.com"},
+		want:           []gatewayv1.Hostname{"foo.example.com"},
+		wantOK:         true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := validationutils.IntersectHostnames(tc.listenerHostname, tc.routeHostnames)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHostnameIntersection(t *testing.T) {
+	testCases := []struct {
+		a, b   gatewayv1.Hostname
+		want   gatewayv1.Hostname
+		wantOK bool
+	}{
+		{a: "foo.example.com", b: "foo.example.com", want: "foo.example.com", wantOK: true},
+		{a: "foo.example.com", b: "bar.example.com"},
+		{a: "*.example.com", b: "foo.example.com", want: "foo.example.com", wantOK: true},
+		{a: "foo.example.com", b: "*.example.com", want: "foo.example.com", wantOK: true},
+		{a: "*.example.com", b: "*.foo.example.com", want: "*.foo.example.com", wantOK: true},
+		{a: "*.foo.example.com", b: "*.example.com", want: "*.foo.example.com", wantOK: true},
+		{a: "*.example.com", b: "example.com"},
+		{a: "*.example.com", b: "*.example.net"},
+		{a: "*.example.com", b: "fooexample.com"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(string(tc.a)+"/"+string(tc.b), func(t *testing.T) {
+			got, ok := validationutils.HostnameIntersection(tc.a, tc.b)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHostnameMatches(t *testing.T) {
+	assert.True(t, validationutils.HostnameMatches("foo.example.com", "foo.example.com"))
+	assert.False(t, validationutils.HostnameMatches("foo.example.com", "bar.example.com"))
+	assert.True(t, validationutils.HostnameMatches("*.example.com", "foo.example.com"))
+	assert.True(t, validationutils.HostnameMatches("*.example.com", "foo.bar.example.com"))
+	assert.False(t, validationutils.HostnameMatches("*.example.com", "example.com"))
+}
+
+func hostname(h string) *gatewayv1.Hostname {
+	hostname := gatewayv1.Hostname(h)
+	return &hostname
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ListenerConflict describes why a Listener is not distinct from other
+// Listeners of the same set.
+type ListenerConflict struct {
+	// Reason is either ListenerReasonProtocolConflict or
+	// ListenerReasonHostnameConflict.
+	Reason gatewayv1.ListenerConditionReason
+
+	// ConflictsWith lists the names of the other Listeners this Listener
+	// conflicts with for Reason, in the order of the set.
+	ConflictsWith []gatewayv1.SectionName
+}
+
+// ListenerConflicts computes which Listeners of a set are not distinct, as
+// defined on GatewaySpec.Listeners, and returns their conflicts keyed by the
+// index of the Listener in the set, as names may not be unique in Gateways
+// that weren't validated yet. Listeners without conflicts are not part of the
+// result.
+//
+// Listeners on the same port conflict with a ProtocolConflict when they can
+// not be told apart by their protocol:
+//
+//   - HTTP Listeners only share a port with other HTTP Listeners.
+//   - HTTPS and TLS Listeners share a port with each other, as both are
+//     matched on SNI.
+//   - TCP, UDP and implementation specific protocols can not be matched on
+//     hostname, so they conflict with any other Listener of the same
+//     transport. Only UDP uses a different transport than the others.
+//
+// Listeners on the same port with compatible protocols conflict with a
+// HostnameConflict when they have the same hostname, an empty hostname being
+// equal to no hostname. TLS passthrough Listeners additionally conflict when
+// both use wildcard hostnames that overlap, as the connection is not
+// terminated and can not be routed on the certificate presented.
+//
+// A Listener with both kinds of conflicts is reported with ProtocolConflict.
+func ListenerConflicts(listeners []gatewayv1.Listener) map[int]ListenerConflict {
+	protocolConflicts := map[int][]gatewayv1.SectionName{}
+	hostnameConflicts := map[int][]gatewayv1.SectionName{}
+
+	for i := range listeners {
+		a := &listeners[i]
+		for j := range listeners {
+			b := &listeners[j]
+			// Listeners using different transports, such as TCP and UDP,
+			// never conflict.
+			if i == j || a.Port != b.Port || listenerTransport(a.Protocol) != listenerTransport(b.Protocol) {
+				continue
+			}
+			switch {
+			case protocolsConflict(a.Protocol, b.Protocol):
+				protocolConflicts[i] = append(protocolConflicts[i], b.Name)
+			case hostnamesConflict(a, b):
+				hostnameConflicts[i] = append(hostnameConflicts[i], b.Name)
+			}
+		}
+	}
+
+	conflicts := make(map[int]ListenerConflict, len(protocolConflicts)+len(hostnameConflicts))
+	for i, others := range hostnameConflicts {
+		conflicts[i] = ListenerConflict{Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: others}
+	}
+	for i, others := range protocolConflicts {
+		conflicts[i] = ListenerConflict{Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: others}
+	}
+	return conflicts
+}
+
+// ListenerConflictedCondition returns the Conflicted condition of a Listener
+// for the given conflict, as expected in the Listener status. A nil conflict
+// results in a False condition with the NoConflicts reason.
+func ListenerConflictedCondition(conflict *ListenerConflict, observedGeneration int64) metav1.Condition {
+	if conflict == nil {
+		return metav1.Condition{
+			Type:               string(gatewayv1.ListenerConditionConflicted),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(gatewayv1.ListenerReasonNoConflicts),
+			Message:            "No conflicts",
+			ObservedGeneration: observedGeneration,
+		}
+	}
+	return metav1.Condition{
+		Type:               string(gatewayv1.ListenerConditionConflicted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(conflict.Reason),
+		Message:            conflict.String(),
+		ObservedGeneration: observedGeneration,
+	}
+}
+
+// String describes the conflict in a human readable form.
+func (c ListenerConflict) String() string {
+	names := make([]string, 0, len(c.ConflictsWith))
+	for _, name := range c.ConflictsWith {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	switch c.Reason {
+	case gatewayv1.ListenerReasonProtocolConflict:
+		return fmt.Sprintf("protocol conflicts with Listeners %s on the same port", strings.Join(names, ", "))
+	case gatewayv1.ListenerReasonHostnameConflict:
+		return fmt.Sprintf("hostname conflicts with Listeners %s on the same port", strings.Join(names, ", "))
+	}
+	return fmt.Sprintf("conflicts with Listeners %s", strings.Join(names, ", "))
+}
+
+// protocolsConflict reports whether two Listeners on the same port and
+// transport can not be told apart by their protocols.
+func protocolsConflict(a, b gatewayv1.ProtocolType) bool {
+	if !protocolMatchesHostname(a) || !protocolMatchesHostname(b) {
+		return true
+	}
+	return listenerProtocolClass(a) != listenerProtocolClass(b)
+}
+
+// hostnamesConflict reports whether two Listeners on the same port with
+// compatible protocols can not be told apart by their hostnames.
+func hostnamesConflict(a, b *gatewayv1.Listener) bool {
+	ha, hb := listenerHostname(a), listenerHostname(b)
+	if ha == hb {
+		return true
+	}
+	if isTLSPassthrough(a) && isTLSPassthrough(b) && isWildcardHostname(ha) && isWildcardHostname(hb) {
+		_, ok := HostnameIntersection(ha, hb)
+		return ok
+	}
+	return false
+}
+
+func listenerTransport(protocol gatewayv1.ProtocolType) string {
+	if protocol == gatewayv1.UDPProtocolType {
+		return "udp"
+	}
+	return "tcp"
+}
+
+// protocolMatchesHostname reports whether Listeners of the protocol can be
+// distinguished by hostname.
+func protocolMatchesHostname(protocol gatewayv1.ProtocolType) bool {
+	switch protocol {
+	case gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType, gatewayv1.TLSProtocolType:
+		return true
+	}
+	return false
+}
+
+// listenerProtocolClass groups the protocols that may share a port.
+func listenerProtocolClass(protocol gatewayv1.ProtocolType) string {
+	if protocol == gatewayv1.HTTPSProtocolType || protocol == gatewayv1.TLSProtocolType {
+		return "sni"
+	}
+	return string(protocol)
+}
+
+func listenerHostname(listener *gatewayv1.Listener) gatewayv1.Hostname {
+	if listener.Hostname == nil {
+		return ""
+	}
+	return *listener.Hostname
+}
+
+func isTLSPassthrough(listener *gatewayv1.Listener) bool {
+	return listener.TLS != nil && listener.TLS.Mode != nil && *listener.TLS.Mode == gatewayv1.TLSModePassthrough
+}
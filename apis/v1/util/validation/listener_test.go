@@ -0,0 +1,202 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1/util/validation"
+)
+
+func TestListenerConflicts(t *testing.T) {
+	passthrough := gatewayv1.TLSModePassthrough
+	terminate := gatewayv1.TLSModeTerminate
+
+	listener := func(name string, port gatewayv1.PortNumber, protocol gatewayv1.ProtocolType, hostname string) gatewayv1.Listener {
+		l := gatewayv1.Listener{Name: gatewayv1.SectionName(name), Port: port, Protocol: protocol}
+		if hostname != "" {
+			h := gatewayv1.Hostname(hostname)
+			l.Hostname = &h
+		}
+		return l
+	}
+	withTLSMode := func(l gatewayv1.Listener, mode *gatewayv1.TLSModeType) gatewayv1.Listener {
+		l.TLS = &gatewayv1.GatewayTLSConfig{Mode: mode}
+		return l
+	}
+
+	testCases := []struct {
+		name      string
+		listeners []gatewayv1.Listener
+		expected  map[int]validationutils.ListenerConflict
+	}{{
+		name: "HTTP listeners with distinct hostnames",
+		listeners: []gatewayv1.Listener{
+			listener("a", 80, gatewayv1.HTTPProtocolType, "foo.example.com"),
+			listener("b", 80, gatewayv1.HTTPProtocolType, "*.example.com"),
+			listener("c", 80, gatewayv1.HTTPProtocolType, ""),
+		},
+		expected: map[int]validationutils.ListenerConflict{},
+	}, {
+		name: "HTTPS and TLS listeners with distinct hostnames",
+		listeners: []gatewayv1.Listener{
+			withTLSMode(listener("a", 443, gatewayv1.HTTPSProtocolType, "foo.example.com"), &terminate),
+			withTLSMode(listener("b", 443, gatewayv1.TLSProtocolType, "bar.example.com"), &passthrough),
+		},
+		expected: map[int]validationutils.ListenerConflict{},
+	}, {
+		name: "TCP and UDP listeners on the same port",
+		listeners: []gatewayv1.Listener{
+			listener("a", 53, gatewayv1.TCPProtocolType, ""),
+			listener("b", 53, gatewayv1.UDPProtocolType, ""),
+		},
+		expected: map[int]validationutils.ListenerConflict{},
+	}, {
+		name: "different ports never conflict",
+		listeners: []gatewayv1.Listener{
+			listener("a", 80, gatewayv1.HTTPProtocolType, ""),
+			listener("b", 8080, gatewayv1.TCPProtocolType, ""),
+		},
+		expected: map[int]validationutils.ListenerConflict{},
+	}, {
+		name: "TCP and HTTP listeners on the same port",
+		listeners: []gatewayv1.Listener{
+			listener("a", 80, gatewayv1.TCPProtocolType, ""),
+			listener("b", 80, gatewayv1.HTTPProtocolType, "foo.example.com"),
+		},
+		expected: map[int]validationutils.ListenerConflict{
+			0: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"b"}},
+			1: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"a"}},
+		},
+	}, {
+		name: "HTTP and HTTPS listeners on the same port",
+		listeners: []gatewayv1.Listener{
+			listener("a", 8443, gatewayv1.HTTPProtocolType, "foo.example.com"),
+			withTLSMode(listener("b", 8443, gatewayv1.HTTPSProtocolType, "bar.example.com"), nil),
+		},
+		expected: map[int]validationutils.ListenerConflict{
+			0: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"b"}},
+			1: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"a"}},
+		},
+	}, {
+		name: "two TCP listeners on the same port",
+		listeners: []gatewayv1.Listener{
+			listener("a", 5432, gatewayv1.TCPProtocolType, ""),
+			listener("b", 5432, gatewayv1.TCPProtocolType, ""),
+		},
+		expected: map[int]validationutils.ListenerConflict{
+			0: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"b"}},
+			1: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"a"}},
+		},
+	}, {
+		name: "HTTP listeners with the same hostname",
+		listeners: []gatewayv1.Listener{
+			listener("a", 80, gatewayv1.HTTPProtocolType, "foo.example.com"),
+			listener("b", 80, gatewayv1.HTTPProtocolType, "foo.example.com"),
+			listener("c", 80, gatewayv1.HTTPProtocolType, "bar.example.com"),
+		},
+		expected: map[int]validationutils.ListenerConflict{
+			0: {Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: []gatewayv1.SectionName{"b"}},
+			1: {Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: []gatewayv1.SectionName{"a"}},
+		},
+	}, {
+		name: "HTTPS and TLS passthrough listeners with the same hostname",
+		listeners: []gatewayv1.Listener{
+			withTLSMode(listener("a", 443, gatewayv1.HTTPSProtocolType, "foo.example.com"), &terminate),
+			withTLSMode(listener("b", 443, gatewayv1.TLSProtocolType, "foo.example.com"), &passthrough),
+		},
+		expected: map[int]validationutils.ListenerConflict{
+			0: {Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: []gatewayv1.SectionName{"b"}},
+			1: {Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: []gatewayv1.SectionName{"a"}},
+		},
+	}, {
+		name: "TLS passthrough listeners with overlapping wildcard hostnames",
+		listeners: []gatewayv1.Listener{
+			withTLSMode(listener("a", 443, gatewayv1.TLSProtocolType, "*.example.com"), &passthrough),
+			withTLSMode(listener("b", 443, gatewayv1.TLSProtocolType, "*.foo.example.com"), &passthrough),
+			withTLSMode(listener("c", 443, gatewayv1.TLSProtocolType, "*.example.org"), &passthrough),
+		},
+		expected: map[int]validationutils.ListenerConflict{
+			0: {Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: []gatewayv1.SectionName{"b"}},
+			1: {Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: []gatewayv1.SectionName{"a"}},
+		},
+	}, {
+		name: "HTTPS listeners with overlapping wildcard hostnames",
+		listeners: []gatewayv1.Listener{
+			withTLSMode(listener("a", 443, gatewayv1.HTTPSProtocolType, "*.example.com"), &terminate),
+			withTLSMode(listener("b", 443, gatewayv1.HTTPSProtocolType, "*.foo.example.com"), &terminate),
+		},
+		expected: map[int]validationutils.ListenerConflict{},
+	}, {
+		name: "protocol conflicts take precedence over hostname conflicts",
+		listeners: []gatewayv1.Listener{
+			listener("a", 80, gatewayv1.HTTPProtocolType, ""),
+			listener("b", 80, gatewayv1.HTTPProtocolType, ""),
+			listener("c", 80, gatewayv1.TCPProtocolType, ""),
+		},
+		expected: map[int]validationutils.ListenerConflict{
+			0: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"c"}},
+			1: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"c"}},
+			2: {Reason: gatewayv1.ListenerReasonProtocolConflict, ConflictsWith: []gatewayv1.SectionName{"a", "b"}},
+		},
+	}, {
+		name: "listeners with duplicate names",
+		listeners: []gatewayv1.Listener{
+			listener("a", 80, gatewayv1.HTTPProtocolType, "foo.example.com"),
+			listener("a", 80, gatewayv1.HTTPProtocolType, "bar.example.com"),
+			listener("b", 80, gatewayv1.HTTPProtocolType, "foo.example.com"),
+		},
+		expected: map[int]validationutils.ListenerConflict{
+			0: {Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: []gatewayv1.SectionName{"b"}},
+			2: {Reason: gatewayv1.ListenerReasonHostnameConflict, ConflictsWith: []gatewayv1.SectionName{"a"}},
+		},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, validationutils.ListenerConflicts(tc.listeners))
+		})
+	}
+}
+
+func TestListenerConflictedCondition(t *testing.T) {
+	condition := validationutils.ListenerConflictedCondition(nil, 3)
+	assert.Equal(t, metav1.Condition{
+		Type:               string(gatewayv1.ListenerConditionConflicted),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(gatewayv1.ListenerReasonNoConflicts),
+		Message:            "No conflicts",
+		ObservedGeneration: 3,
+	}, condition)
+
+	condition = validationutils.ListenerConflictedCondition(&validationutils.ListenerConflict{
+		Reason:        gatewayv1.ListenerReasonProtocolConflict,
+		ConflictsWith: []gatewayv1.SectionName{"a", "b"},
+	}, 4)
+	assert.Equal(t, metav1.Condition{
+		Type:               string(gatewayv1.ListenerConditionConflicted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayv1.ListenerReasonProtocolConflict),
+		Message:            `protocol conflicts with Listeners "a", "b" on the same port`,
+		ObservedGeneration: 4,
+	}, condition)
+}
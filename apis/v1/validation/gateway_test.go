@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	gatewayvalidation "sigs.k8s.io/gateway-api/apis/internal/validation"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -369,13 +370,13 @@ func TestValidateGateway(t *testing.T) {
 				{
 					Type:     field.ErrorTypeInvalid,
 					Field:    "spec.addresses[1]",
-					Detail:   fmt.Sprintf("must only contain valid characters (matching %s)", validHostnameAddress),
+					Detail:   fmt.Sprintf("must only contain valid characters (matching %s)", gatewayvalidation.ValidHostnameAddress),
 					BadValue: "*foo/bar",
 				},
 				{
 					Type:     field.ErrorTypeInvalid,
 					Field:    "spec.addresses[2]",
-					Detail:   fmt.Sprintf("must only contain valid characters (matching %s)", validHostnameAddress),
+					Detail:   fmt.Sprintf("must only contain valid characters (matching %s)", gatewayvalidation.ValidHostnameAddress),
 					BadValue: "12:34:56::",
 				},
 			},
@@ -1282,6 +1282,27 @@ func TestValidateHTTPTimeouts(t *testing.T) {
 					},
 				},
 			},
+		}, {
+			name:     "invalid httpRoute Rules request timeout with fractional value",
+			errCount: 1,
+			rules: []gatewayv1.HTTPRouteRule{
+				{
+					Timeouts: &gatewayv1.HTTPRouteTimeouts{
+						Request: toDuration("1.5s"),
+					},
+				},
+			},
+		}, {
+			name:     "invalid httpRoute Rules backendRequest timeout with unsupported unit",
+			errCount: 1,
+			rules: []gatewayv1.HTTPRouteRule{
+				{
+					Timeouts: &gatewayv1.HTTPRouteTimeouts{
+						Request:        toDuration("1h"),
+						BackendRequest: toDuration("1d"),
+					},
+				},
+			},
 		}, {
 			name:     "valid httpRoute Rules request 0s (infinite) and backendRequest 100ms",
 			errCount: 0,
@@ -1305,3 +1326,36 @@ func TestValidateHTTPTimeouts(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHTTPRouteParentRefs(t *testing.T) {
+	tests := []struct {
+		name       string
+		parentRefs []gatewayv1.ParentReference
+		errFields  []string
+	}{{
+		name: "duplicate ports are reported under spec.parentRefs",
+		parentRefs: []gatewayv1.ParentReference{
+			{Name: "gateway", Port: ptrTo(gatewayv1.PortNumber(80))},
+			{Name: "gateway", Port: ptrTo(gatewayv1.PortNumber(80))},
+		},
+		errFields: []string{"spec.parentRefs[1].port"},
+	}, {
+		name: "missing sectionNames or ports are reported under spec.parentRefs",
+		parentRefs: []gatewayv1.ParentReference{
+			{Name: "gateway"},
+			{Name: "gateway"},
+		},
+		errFields: []string{"spec.parentRefs"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := gatewayv1.HTTPRoute{Spec: gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: tc.parentRefs}}}
+			errs := ValidateHTTPRoute(&route)
+			var errFields []string
+			for _, err := range errs {
+				errFields = append(errFields, err.Field)
+			}
+			assert.Equal(t, tc.errFields, errFields, errs)
+		})
+	}
+}
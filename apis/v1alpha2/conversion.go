@@ -0,0 +1,314 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// The v1alpha2 types share their schema with the types of the hub
+// versions, v1 for most types and v1beta1 for ReferenceGrant, so converting
+// between them is a deep copy that only updates the TypeMeta.
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds the conversion functions between v1alpha2 and
+// the hub versions to the given scheme.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddConversionFunc((*GatewayClass)(nil), (*v1.GatewayClass)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertGatewayClassToV1(a.(*GatewayClass), b.(*v1.GatewayClass))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.GatewayClass)(nil), (*GatewayClass)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertGatewayClassFromV1(a.(*v1.GatewayClass), b.(*GatewayClass))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*GatewayClassList)(nil), (*v1.GatewayClassList)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertGatewayClassListToV1(a.(*GatewayClassList), b.(*v1.GatewayClassList))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.GatewayClassList)(nil), (*GatewayClassList)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertGatewayClassListFromV1(a.(*v1.GatewayClassList), b.(*GatewayClassList))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Gateway)(nil), (*v1.Gateway)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertGatewayToV1(a.(*Gateway), b.(*v1.Gateway))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.Gateway)(nil), (*Gateway)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertGatewayFromV1(a.(*v1.Gateway), b.(*Gateway))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*GatewayList)(nil), (*v1.GatewayList)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertGatewayListToV1(a.(*GatewayList), b.(*v1.GatewayList))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.GatewayList)(nil), (*GatewayList)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertGatewayListFromV1(a.(*v1.GatewayList), b.(*GatewayList))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*HTTPRoute)(nil), (*v1.HTTPRoute)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertHTTPRouteToV1(a.(*HTTPRoute), b.(*v1.HTTPRoute))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.HTTPRoute)(nil), (*HTTPRoute)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertHTTPRouteFromV1(a.(*v1.HTTPRoute), b.(*HTTPRoute))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*HTTPRouteList)(nil), (*v1.HTTPRouteList)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertHTTPRouteListToV1(a.(*HTTPRouteList), b.(*v1.HTTPRouteList))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.HTTPRouteList)(nil), (*HTTPRouteList)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertHTTPRouteListFromV1(a.(*v1.HTTPRouteList), b.(*HTTPRouteList))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*ReferenceGrant)(nil), (*v1beta1.ReferenceGrant)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertReferenceGrantToV1beta1(a.(*ReferenceGrant), b.(*v1beta1.ReferenceGrant))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.ReferenceGrant)(nil), (*ReferenceGrant)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertReferenceGrantFromV1beta1(a.(*v1beta1.ReferenceGrant), b.(*ReferenceGrant))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*ReferenceGrantList)(nil), (*v1beta1.ReferenceGrantList)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertReferenceGrantListToV1beta1(a.(*ReferenceGrantList), b.(*v1beta1.ReferenceGrantList))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.ReferenceGrantList)(nil), (*ReferenceGrantList)(nil), func(a, b any, _ conversion.Scope) error {
+		return ConvertReferenceGrantListFromV1beta1(a.(*v1beta1.ReferenceGrantList), b.(*ReferenceGrantList))
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConvertGatewayClassToV1 converts a v1alpha2 GatewayClass to v1.
+func ConvertGatewayClassToV1(in *GatewayClass, out *v1.GatewayClass) error {
+	*out = v1.GatewayClass(*in.DeepCopy())
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, v1.SchemeGroupVersion)
+	return nil
+}
+
+// ConvertGatewayClassFromV1 converts a v1 GatewayClass to v1alpha2.
+func ConvertGatewayClassFromV1(in *v1.GatewayClass, out *GatewayClass) error {
+	*out = GatewayClass(*in.DeepCopy())
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, SchemeGroupVersion)
+	return nil
+}
+
+// ConvertGatewayClassListToV1 converts a v1alpha2 GatewayClassList to v1.
+func ConvertGatewayClassListToV1(in *GatewayClassList, out *v1.GatewayClassList) error {
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, v1.SchemeGroupVersion)
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	out.Items = nil
+	if in.Items != nil {
+		out.Items = make([]v1.GatewayClass, len(in.Items))
+		for i := range in.Items {
+			if err := ConvertGatewayClassToV1(&in.Items[i], &out.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertGatewayClassListFromV1 converts a v1 GatewayClassList to v1alpha2.
+func ConvertGatewayClassListFromV1(in *v1.GatewayClassList, out *GatewayClassList) error {
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, SchemeGroupVersion)
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	out.Items = nil
+	if in.Items != nil {
+		out.Items = make([]GatewayClass, len(in.Items))
+		for i := range in.Items {
+			if err := ConvertGatewayClassFromV1(&in.Items[i], &out.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertGatewayToV1 converts a v1alpha2 Gateway to v1.
+func ConvertGatewayToV1(in *Gateway, out *v1.Gateway) error {
+	*out = v1.Gateway(*in.DeepCopy())
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, v1.SchemeGroupVersion)
+	return nil
+}
+
+// ConvertGatewayFromV1 converts a v1 Gateway to v1alpha2.
+func ConvertGatewayFromV1(in *v1.Gateway, out *Gateway) error {
+	*out = Gateway(*in.DeepCopy())
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, SchemeGroupVersion)
+	return nil
+}
+
+// ConvertGatewayListToV1 converts a v1alpha2 GatewayList to v1.
+func ConvertGatewayListToV1(in *GatewayList, out *v1.GatewayList) error {
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, v1.SchemeGroupVersion)
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	out.Items = nil
+	if in.Items != nil {
+		out.Items = make([]v1.Gateway, len(in.Items))
+		for i := range in.Items {
+			if err := ConvertGatewayToV1(&in.Items[i], &out.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertGatewayListFromV1 converts a v1 GatewayList to v1alpha2.
+func ConvertGatewayListFromV1(in *v1.GatewayList, out *GatewayList) error {
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, SchemeGroupVersion)
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	out.Items = nil
+	if in.Items != nil {
+		out.Items = make([]Gateway, len(in.Items))
+		for i := range in.Items {
+			if err := ConvertGatewayFromV1(&in.Items[i], &out.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertHTTPRouteToV1 converts a v1alpha2 HTTPRoute to v1.
+func ConvertHTTPRouteToV1(in *HTTPRoute, out *v1.HTTPRoute) error {
+	*out = v1.HTTPRoute(*in.DeepCopy())
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, v1.SchemeGroupVersion)
+	return nil
+}
+
+// ConvertHTTPRouteFromV1 converts a v1 HTTPRoute to v1alpha2.
+func ConvertHTTPRouteFromV1(in *v1.HTTPRoute, out *HTTPRoute) error {
+	*out = HTTPRoute(*in.DeepCopy())
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, SchemeGroupVersion)
+	return nil
+}
+
+// ConvertHTTPRouteListToV1 converts a v1alpha2 HTTPRouteList to v1.
+func ConvertHTTPRouteListToV1(in *HTTPRouteList, out *v1.HTTPRouteList) error {
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, v1.SchemeGroupVersion)
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	out.Items = nil
+	if in.Items != nil {
+		out.Items = make([]v1.HTTPRoute, len(in.Items))
+		for i := range in.Items {
+			if err := ConvertHTTPRouteToV1(&in.Items[i], &out.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertHTTPRouteListFromV1 converts a v1 HTTPRouteList to v1alpha2.
+func ConvertHTTPRouteListFromV1(in *v1.HTTPRouteList, out *HTTPRouteList) error {
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, SchemeGroupVersion)
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	out.Items = nil
+	if in.Items != nil {
+		out.Items = make([]HTTPRoute, len(in.Items))
+		for i := range in.Items {
+			if err := ConvertHTTPRouteFromV1(&in.Items[i], &out.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertReferenceGrantToV1beta1 converts a v1alpha2 ReferenceGrant to v1beta1.
+func ConvertReferenceGrantToV1beta1(in *ReferenceGrant, out *v1beta1.ReferenceGrant) error {
+	*out = v1beta1.ReferenceGrant(*in.DeepCopy())
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, v1beta1.SchemeGroupVersion)
+	return nil
+}
+
+// ConvertReferenceGrantFromV1beta1 converts a v1beta1 ReferenceGrant to v1alpha2.
+func ConvertReferenceGrantFromV1beta1(in *v1beta1.ReferenceGrant, out *ReferenceGrant) error {
+	*out = ReferenceGrant(*in.DeepCopy())
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, SchemeGroupVersion)
+	return nil
+}
+
+// ConvertReferenceGrantListToV1beta1 converts a v1alpha2 ReferenceGrantList to v1beta1.
+func ConvertReferenceGrantListToV1beta1(in *ReferenceGrantList, out *v1beta1.ReferenceGrantList) error {
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, v1beta1.SchemeGroupVersion)
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	out.Items = nil
+	if in.Items != nil {
+		out.Items = make([]v1beta1.ReferenceGrant, len(in.Items))
+		for i := range in.Items {
+			if err := ConvertReferenceGrantToV1beta1(&in.Items[i], &out.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertReferenceGrantListFromV1beta1 converts a v1beta1 ReferenceGrantList to v1alpha2.
+func ConvertReferenceGrantListFromV1beta1(in *v1beta1.ReferenceGrantList, out *ReferenceGrantList) error {
+	out.TypeMeta = convertTypeMeta(in.TypeMeta, SchemeGroupVersion)
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	out.Items = nil
+	if in.Items != nil {
+		out.Items = make([]ReferenceGrant, len(in.Items))
+		for i := range in.Items {
+			if err := ConvertReferenceGrantFromV1beta1(&in.Items[i], &out.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// convertTypeMeta returns the TypeMeta of an object converted to gv. Empty
+// TypeMetas, such as the ones of list items, are kept empty.
+func convertTypeMeta(in metav1.TypeMeta, gv schema.GroupVersion) metav1.TypeMeta {
+	if in.APIVersion == "" && in.Kind == "" {
+		return in
+	}
+	return metav1.TypeMeta{APIVersion: gv.String(), Kind: in.Kind}
+}
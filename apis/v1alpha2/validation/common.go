@@ -17,20 +17,51 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
+	"net"
+	"strings"
+
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	gatewayvalidation "sigs.k8s.io/gateway-api/apis/internal/validation"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	v1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
-	gatewayvalidationv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 )
 
-// validateParentRefs validates ParentRefs SectionName must be set and unique
-// when ParentRefs includes 2 or more references to the same parent
-var validateParentRefs = gatewayvalidationv1b1.ValidateParentRefs
+// The v1alpha2 entry points validate these types with the v1 validation core,
+// which is only correct while they are aliases of the v1 types. These
+// assignments stop compiling as soon as v1alpha2 defines one of them with
+// fields of its own, which then need their own validation.
+var (
+	_ *gatewayv1.GatewaySpec     = (*v1a2.GatewaySpec)(nil)
+	_ *gatewayv1.Listener        = (*v1a2.Listener)(nil)
+	_ *gatewayv1.HTTPRouteSpec   = (*v1a2.HTTPRouteSpec)(nil)
+	_ *gatewayv1.ParentReference = (*v1a2.ParentReference)(nil)
+)
+
+const (
+	// maxRouteItems is the maximum number of rules, backendRefs and
+	// hostnames of a route allowed by the CRD schemas.
+	maxRouteItems = 16
+
+	// maxBackendRefWeight is the maximum weight of a backendRef allowed by
+	// the CRD schemas.
+	maxBackendRefWeight = 1000000
+)
+
+// ValidateParentRefs validates ParentRefs SectionName must be set and unique
+// when ParentRefs includes 2 or more references to the same parent. The
+// experimental Port field is taken into account: references to the same
+// parent must then differ in their SectionName or Port.
+func ValidateParentRefs(parentRefs []v1a2.ParentReference, path *field.Path) field.ErrorList {
+	return gatewayvalidation.ValidateParentRefs(parentRefs, path)
+}
 
 // validateBackendRefServicePort validates whether or not a port was specified
 // for a backendRef which refers to a corev1.Service, asserting that the port
 // field is required.
-func validateBackendRefServicePort(ref *v1a2.BackendRef, path *field.Path) field.ErrorList {
+func validateBackendRefServicePort(ref *v1a2.BackendObjectReference, path *field.Path) field.ErrorList {
 	var errs field.ErrorList
 
 	if ref.Group != nil && *ref.Group != "" {
@@ -48,6 +79,81 @@ func validateBackendRefServicePort(ref *v1a2.BackendRef, path *field.Path) field
 	return errs
 }
 
+// validateRouteRulesCount validates that a route has between 1 and
+// maxRouteItems rules.
+func validateRouteRulesCount(count int, path *field.Path) field.ErrorList {
+	if count == 0 {
+		return field.ErrorList{field.Required(path, "must specify at least one rule")}
+	}
+	if count > maxRouteItems {
+		return field.ErrorList{field.TooMany(path, count, maxRouteItems)}
+	}
+	return nil
+}
+
+// validateBackendRefs validates the backendRefs of a TCPRoute, TLSRoute or
+// UDPRoute rule: there must be between 1 and maxRouteItems of them, Service
+// references must specify a port, and weights must be in range with at least
+// one of them being non-zero, as otherwise every connection is rejected.
+func validateBackendRefs(refs []v1a2.BackendRef, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if len(refs) == 0 {
+		return field.ErrorList{field.Required(path, "must specify at least one backendRef")}
+	}
+	if len(refs) > maxRouteItems {
+		errs = append(errs, field.TooMany(path, len(refs), maxRouteItems))
+	}
+
+	hasWeight := false
+	for i := range refs {
+		ref := &refs[i]
+		errs = append(errs, validateBackendRefServicePort(&ref.BackendObjectReference, path.Index(i))...)
+		if ref.Weight == nil {
+			hasWeight = true
+			continue
+		}
+		if *ref.Weight < 0 || *ref.Weight > maxBackendRefWeight {
+			errs = append(errs, field.Invalid(path.Index(i).Child("weight"), *ref.Weight, fmt.Sprintf("must be between 0 and %d", maxBackendRefWeight)))
+		}
+		if *ref.Weight > 0 {
+			hasWeight = true
+		}
+	}
+	if !hasWeight {
+		errs = append(errs, field.Invalid(path, 0, "at least one backendRef must have a non-zero weight"))
+	}
+	return errs
+}
+
+// validateSNIHostnames validates that hostnames can be matched against the
+// TLS SNI: they must be DNS names, optionally prefixed with a wildcard label,
+// and can't be IP addresses or contain ports.
+func validateSNIHostnames(hostnames []v1a2.Hostname, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if len(hostnames) > maxRouteItems {
+		errs = append(errs, field.TooMany(path, len(hostnames), maxRouteItems))
+	}
+	for i, h := range hostnames {
+		hostname := string(h)
+		var msgs []string
+		if net.ParseIP(hostname) != nil {
+			msgs = []string{"must not be an IP address"}
+		} else if _, _, err := net.SplitHostPort(hostname); err == nil {
+			msgs = []string{"must not contain a port"}
+		} else if strings.HasPrefix(hostname, "*.") {
+			msgs = utilvalidation.IsWildcardDNS1123Subdomain(hostname)
+		} else if strings.Contains(hostname, "*") {
+			msgs = []string{"wildcard must be the first label, followed by a dot, e.g. *.example.com"}
+		} else {
+			msgs = utilvalidation.IsDNS1123Subdomain(hostname)
+		}
+		if len(msgs) > 0 {
+			errs = append(errs, field.Invalid(path.Index(i), h, strings.Join(msgs, "; ")))
+		}
+	}
+	return errs
+}
+
 func ptrTo[T any](a T) *T {
 	return &a
 }
@@ -19,8 +19,8 @@ package validation
 import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	gatewayvalidation "sigs.k8s.io/gateway-api/apis/internal/validation"
 	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
-	gatewayv1b1validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 )
 
 // ValidateGateway validates gw according to the Gateway API specification.
@@ -31,5 +31,40 @@ import (
 // Validation that is not possible with CRD annotations may be added here in the future.
 // See https://github.com/kubernetes-sigs/gateway-api/issues/868 for more information.
 func ValidateGateway(gw *gatewayv1a2.Gateway) field.ErrorList {
-	return gatewayv1b1validation.ValidateGatewaySpec(&gw.Spec, field.NewPath("spec"))
+	return ValidateGatewaySpec(&gw.Spec, field.NewPath("spec"))
+}
+
+// ValidateGatewaySpec validates whether required fields of spec are set according to the
+// Gateway API specification. The v1alpha2 GatewaySpec shares its schema with v1,
+// including the experimental fields, so the same rules apply to both versions.
+func ValidateGatewaySpec(spec *gatewayv1a2.GatewaySpec, path *field.Path) field.ErrorList {
+	return gatewayvalidation.ValidateGatewaySpec(spec, path)
+}
+
+// ValidateListenerTLSConfig validates TLS config must be set when protocol is HTTPS or TLS,
+// and TLS config shall not be present when protocol is HTTP, TCP or UDP
+func ValidateListenerTLSConfig(listeners []gatewayv1a2.Listener, path *field.Path) field.ErrorList {
+	return gatewayvalidation.ValidateListenerTLSConfig(listeners, path)
+}
+
+// ValidateTLSCertificateRefs validates the certificateRefs
+// must be set and not empty when tls config is set and
+// TLSModeType is terminate
+func ValidateTLSCertificateRefs(listeners []gatewayv1a2.Listener, path *field.Path) field.ErrorList {
+	return gatewayvalidation.ValidateTLSCertificateRefs(listeners, path)
+}
+
+// ValidateListenerNames validates the names of the listeners
+// must be unique within the Gateway
+func ValidateListenerNames(listeners []gatewayv1a2.Listener, path *field.Path) field.ErrorList {
+	return gatewayvalidation.ValidateListenerNames(listeners, path)
+}
+
+// ValidateGatewayListenerConflicts returns warnings for the Listeners of gw
+// that conflict with other Listeners, with the ProtocolConflict or
+// HostnameConflict semantics of the Listener Conflicted condition. It is not
+// part of ValidateGateway as conflicted Listeners do not make a Gateway
+// invalid.
+func ValidateGatewayListenerConflicts(gw *gatewayv1a2.Gateway) []string {
+	return gatewayvalidation.ValidateListenerConflicts(gw.Spec.Listeners, field.NewPath("spec", "listeners"))
 }
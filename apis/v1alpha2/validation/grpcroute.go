@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -52,7 +53,7 @@ func ValidateGRPCRoute(route *gatewayv1a2.GRPCRoute) field.ErrorList {
 func validateGRPCRouteSpec(spec *gatewayv1a2.GRPCRouteSpec, path *field.Path) field.ErrorList {
 	var errs field.ErrorList
 	errs = append(errs, validateGRPCRouteRules(spec.Rules, path.Child("rules"))...)
-	errs = append(errs, validateParentRefs(spec.ParentRefs, path.Child("spec"))...)
+	errs = append(errs, ValidateParentRefs(spec.ParentRefs, path)...)
 	return errs
 }
 
@@ -64,12 +65,65 @@ func validateGRPCRouteRules(rules []gatewayv1a2.GRPCRouteRule, path *field.Path)
 		errs = append(errs, validateRuleMatches(rule.Matches, path.Index(i).Child("matches"))...)
 		errs = append(errs, validateGRPCRouteFilters(rule.Filters, path.Index(i).Child(("filters")))...)
 		for j, backendRef := range rule.BackendRefs {
-			errs = append(errs, validateGRPCRouteFilters(backendRef.Filters, path.Child("rules").Index(i).Child("backendRefs").Index(j))...)
+			backendRefPath := path.Index(i).Child("backendRefs").Index(j)
+			errs = append(errs, validateBackendRefServicePort(&backendRef.BackendObjectReference, backendRefPath)...)
+			errs = append(errs, validateGRPCRouteFilters(backendRef.Filters, backendRefPath.Child("filters"))...)
 		}
 	}
+	errs = append(errs, validateGRPCRouteUniqueMatches(rules, path)...)
 	return errs
 }
 
+// validateGRPCRouteUniqueMatches validates that the same match is not
+// specified more than once across the rules of a route, as only the first
+// rule would ever receive the matching requests.
+func validateGRPCRouteUniqueMatches(rules []gatewayv1a2.GRPCRouteRule, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	seen := map[string]*field.Path{}
+	for i, rule := range rules {
+		for j, match := range rule.Matches {
+			matchPath := path.Index(i).Child("matches").Index(j)
+			key := grpcRouteMatchKey(match)
+			if first, ok := seen[key]; ok {
+				errs = append(errs, field.Invalid(matchPath, key, fmt.Sprintf("must not be the same match as %s", first)))
+				continue
+			}
+			seen[key] = matchPath
+		}
+	}
+	return errs
+}
+
+// grpcRouteMatchKey returns a canonical representation of a match, where
+// defaults are applied and header names and order are normalized.
+func grpcRouteMatchKey(match gatewayv1a2.GRPCRouteMatch) string {
+	var parts []string
+	if match.Method != nil {
+		matchType := gatewayv1a2.GRPCMethodMatchExact
+		if match.Method.Type != nil {
+			matchType = *match.Method.Type
+		}
+		service, method := "*", "*"
+		if match.Method.Service != nil {
+			service = *match.Method.Service
+		}
+		if match.Method.Method != nil {
+			method = *match.Method.Method
+		}
+		parts = append(parts, fmt.Sprintf("method %s %s/%s", matchType, service, method))
+	}
+	headers := make([]string, 0, len(match.Headers))
+	for _, header := range match.Headers {
+		headerType := gatewayv1a2.HeaderMatchType(gatewayv1a2.GRPCHeaderMatchExact)
+		if header.Type != nil {
+			headerType = *header.Type
+		}
+		headers = append(headers, fmt.Sprintf("header %s %s=%s", headerType, strings.ToLower(string(header.Name)), header.Value))
+	}
+	sort.Strings(headers)
+	return strings.Join(append(parts, headers...), ", ")
+}
+
 // validateRuleMatches validates GRPCMethodMatch
 func validateRuleMatches(matches []gatewayv1a2.GRPCRouteMatch, path *field.Path) field.ErrorList {
 	var errs field.ErrorList
@@ -164,6 +218,9 @@ func validateGRPCRouteFilters(filters []gatewayv1a2.GRPCRouteFilter, path *field
 		if filter.ResponseHeaderModifier != nil {
 			errs = append(errs, validateGRPCHeaderModifier(*filter.ResponseHeaderModifier, path.Index(i).Child("responseHeaderModifier"))...)
 		}
+		if filter.RequestMirror != nil {
+			errs = append(errs, validateBackendRefServicePort(&filter.RequestMirror.BackendRef, path.Index(i).Child("requestMirror", "backendRef"))...)
+		}
 		errs = append(errs, validateGRPCRouteFilterType(filter, path.Index(i))...)
 	}
 	// repeatableGRPCRouteFilters filters can be used more than once
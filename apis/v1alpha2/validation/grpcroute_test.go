@@ -224,6 +224,7 @@ func TestValidateGRPCRoute(t *testing.T) {
 						RequestMirror: &gatewayv1a2.HTTPRequestMirrorFilter{
 							BackendRef: gatewayv1a2.BackendObjectReference{
 								Name: "Example1",
+								Port: ptrTo(gatewayv1a2.PortNumber(8080)),
 							},
 						},
 					}, {
@@ -231,6 +232,7 @@ func TestValidateGRPCRoute(t *testing.T) {
 						RequestMirror: &gatewayv1a2.HTTPRequestMirrorFilter{
 							BackendRef: gatewayv1a2.BackendObjectReference{
 								Name: "Example2",
+								Port: ptrTo(gatewayv1a2.PortNumber(8080)),
 							},
 						},
 					}},
@@ -273,6 +275,116 @@ func TestValidateGRPCRoute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid GRPCRoute with Service backendRef and RequestMirror without port",
+			rules: []gatewayv1a2.GRPCRouteRule{
+				{
+					Filters: []gatewayv1a2.GRPCRouteFilter{{
+						Type: "RequestMirror",
+						RequestMirror: &gatewayv1a2.HTTPRequestMirrorFilter{
+							BackendRef: gatewayv1a2.BackendObjectReference{
+								Name: "mirror",
+							},
+						},
+					}},
+					BackendRefs: []gatewayv1a2.GRPCBackendRef{{
+						BackendRef: gatewayv1a2.BackendRef{
+							BackendObjectReference: gatewayv1a2.BackendObjectReference{
+								Name: "backend",
+							},
+						},
+					}, {
+						BackendRef: gatewayv1a2.BackendRef{
+							BackendObjectReference: gatewayv1a2.BackendObjectReference{
+								Group: ptrTo(gatewayv1a2.Group("example.com")),
+								Kind:  ptrTo(gatewayv1a2.Kind("Bucket")),
+								Name:  "bucket",
+							},
+						},
+					}},
+				},
+			},
+			errs: field.ErrorList{
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "spec.rules[0].filters[0].requestMirror.backendRef.port",
+					Detail: "missing port for Service reference",
+				},
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "spec.rules[0].backendRefs[0].port",
+					Detail: "missing port for Service reference",
+				},
+			},
+		},
+		{
+			name: "invalid GRPCRoute with the same match in multiple rules",
+			rules: []gatewayv1a2.GRPCRouteRule{
+				{
+					Matches: []gatewayv1a2.GRPCRouteMatch{{
+						Method: &gatewayv1a2.GRPCMethodMatch{
+							Service: &service,
+							Method:  &method,
+						},
+						Headers: []gatewayv1a2.GRPCHeaderMatch{
+							{Name: "version", Value: "2"},
+							{Name: "env", Value: "canary"},
+						},
+					}},
+				},
+				{
+					Matches: []gatewayv1a2.GRPCRouteMatch{{
+						Method: &gatewayv1a2.GRPCMethodMatch{
+							Service: &service,
+						},
+					}, {
+						Method: &gatewayv1a2.GRPCMethodMatch{
+							Type:    ptrTo(gatewayv1a2.GRPCMethodMatchExact),
+							Service: &service,
+							Method:  &method,
+						},
+						Headers: []gatewayv1a2.GRPCHeaderMatch{
+							{Name: "Env", Value: "canary"},
+							{Name: "Version", Value: "2"},
+						},
+					}},
+				},
+			},
+			errs: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					BadValue: "method Exact foo.Test.Example/Login, header Exact env=canary, header Exact version=2",
+					Field:    "spec.rules[1].matches[1]",
+					Detail:   "must not be the same match as spec.rules[0].matches[0]",
+				},
+			},
+		},
+		{
+			name: "GRPCRoute matches differing only by type are not duplicates",
+			rules: []gatewayv1a2.GRPCRouteRule{
+				{
+					Matches: []gatewayv1a2.GRPCRouteMatch{{
+						Method: &gatewayv1a2.GRPCMethodMatch{Service: &regex},
+					}},
+				},
+				{
+					Matches: []gatewayv1a2.GRPCRouteMatch{{
+						Method: &gatewayv1a2.GRPCMethodMatch{
+							Type:    ptrTo(gatewayv1a2.GRPCMethodMatchRegularExpression),
+							Service: &regex,
+						},
+					}},
+				},
+			},
+			errs: field.ErrorList{
+				{
+					Type:     field.ErrorTypeInvalid,
+					BadValue: regex,
+					Field:    "spec.rules[0].matches[0].method",
+					Detail:   "must only contain valid characters (matching ^(?i)\\.?[a-z_][a-z_0-9]*(\\.[a-z_][a-z_0-9]*)*$)",
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -298,6 +410,37 @@ func TestValidateGRPCRoute(t *testing.T) {
 	}
 }
 
+func TestValidateGRPCRouteParentRefs(t *testing.T) {
+	tests := []struct {
+		name       string
+		parentRefs []gatewayv1a2.ParentReference
+		wantField  string
+	}{{
+		name:       "same parent without sectionName or port",
+		parentRefs: []gatewayv1a2.ParentReference{{Name: "gateway"}, {Name: "gateway"}},
+		wantField:  "spec.parentRefs",
+	}, {
+		name: "same parent with the same port",
+		parentRefs: []gatewayv1a2.ParentReference{
+			{Name: "gateway", Port: ptrTo(gatewayv1a2.PortNumber(80))},
+			{Name: "gateway", Port: ptrTo(gatewayv1a2.PortNumber(80))},
+		},
+		wantField: "spec.parentRefs[1].port",
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			route := gatewayv1a2.GRPCRoute{Spec: gatewayv1a2.GRPCRouteSpec{
+				CommonRouteSpec: gatewayv1a2.CommonRouteSpec{ParentRefs: tc.parentRefs},
+			}}
+			errs := ValidateGRPCRoute(&route)
+			require.Len(t, errs, 1, "%s", errs)
+			assert.Equal(t, tc.wantField, errs[0].Field)
+		})
+	}
+}
+
 func TestValidateGRPCBackendUniqueFilters(t *testing.T) {
 	var testService gatewayv1a2.ObjectName = "testService"
 	var specialService gatewayv1a2.ObjectName = "specialService"
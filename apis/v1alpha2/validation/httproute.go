@@ -19,13 +19,29 @@ package validation
 import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	gatewayvalidation "sigs.k8s.io/gateway-api/apis/internal/validation"
 	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
-	gatewayv1b1validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 )
 
 // ValidateHTTPRoute validates HTTPRoute according to the Gateway API specification.
 // For additional details of the HTTPRoute spec, refer to:
 // https://gateway-api.sigs.k8s.io/v1beta1/reference/spec/#gateway.networking.k8s.io/v1beta1.HTTPRoute
 func ValidateHTTPRoute(route *gatewayv1a2.HTTPRoute) field.ErrorList {
-	return gatewayv1b1validation.ValidateHTTPRouteSpec(&route.Spec, field.NewPath("spec"))
+	return ValidateHTTPRouteSpec(&route.Spec, field.NewPath("spec"))
+}
+
+// ValidateHTTPRouteSpec validates that required fields of spec are set according to the
+// HTTPRoute specification. The v1alpha2 HTTPRouteSpec shares its schema with v1,
+// including the experimental fields, so the same rules apply to both versions.
+func ValidateHTTPRouteSpec(spec *gatewayv1a2.HTTPRouteSpec, path *field.Path) field.ErrorList {
+	return gatewayvalidation.ValidateHTTPRouteSpec(spec, path)
+}
+
+// ValidateHTTPRouteRegularExpressions checks the RegularExpression path,
+// header and query param matches of an HTTPRoute. It is not part of
+// ValidateHTTPRoute: invalid RE2 expressions are returned as errors, while
+// expressions that are likely ambiguous or expensive are returned as
+// warnings.
+func ValidateHTTPRouteRegularExpressions(route *gatewayv1a2.HTTPRoute) (field.ErrorList, []string) {
+	return gatewayvalidation.ValidateHTTPRouteRegularExpressions(&route.Spec, field.NewPath("spec"))
 }
@@ -953,3 +953,80 @@ func TestValidateHTTPRouteTypeMatchesField(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHTTPRouteExperimentalFields(t *testing.T) {
+	tests := []struct {
+		name               string
+		mutate             func(spec *gatewayv1a2.HTTPRouteSpec)
+		expectErrsOnFields []string
+	}{{
+		name: "parentRefs to the same Gateway with distinct ports",
+		mutate: func(spec *gatewayv1a2.HTTPRouteSpec) {
+			spec.ParentRefs = []gatewayv1a2.ParentReference{
+				{Name: "gateway", Port: ptrTo(gatewayv1a2.PortNumber(80))},
+				{Name: "gateway", Port: ptrTo(gatewayv1a2.PortNumber(8080))},
+			}
+		},
+	}, {
+		name: "parentRefs to the same Gateway with the same port",
+		mutate: func(spec *gatewayv1a2.HTTPRouteSpec) {
+			spec.ParentRefs = []gatewayv1a2.ParentReference{
+				{Name: "gateway", Port: ptrTo(gatewayv1a2.PortNumber(80))},
+				{Name: "gateway", Port: ptrTo(gatewayv1a2.PortNumber(80))},
+			}
+		},
+		expectErrsOnFields: []string{"spec.parentRefs[1].port"},
+	}, {
+		name: "parentRefs to the same Gateway with and without a port",
+		mutate: func(spec *gatewayv1a2.HTTPRouteSpec) {
+			spec.ParentRefs = []gatewayv1a2.ParentReference{
+				{Name: "gateway", Port: ptrTo(gatewayv1a2.PortNumber(80))},
+				{Name: "gateway"},
+			}
+		},
+		expectErrsOnFields: []string{"spec.parentRefs"},
+	}, {
+		name: "valid timeouts",
+		mutate: func(spec *gatewayv1a2.HTTPRouteSpec) {
+			spec.Rules[0].Timeouts = &gatewayv1a2.HTTPRouteTimeouts{
+				Request:        ptrTo(gatewayv1a2.Duration("10s")),
+				BackendRequest: ptrTo(gatewayv1a2.Duration("5s")),
+			}
+		},
+	}, {
+		name: "invalid timeout duration",
+		mutate: func(spec *gatewayv1a2.HTTPRouteSpec) {
+			spec.Rules[0].Timeouts = &gatewayv1a2.HTTPRouteTimeouts{
+				Request: ptrTo(gatewayv1a2.Duration("1.5s")),
+			}
+		},
+		expectErrsOnFields: []string{"spec.rules[0].timeouts.request"},
+	}, {
+		name: "backendRequest timeout longer than request timeout",
+		mutate: func(spec *gatewayv1a2.HTTPRouteSpec) {
+			spec.Rules[0].Timeouts = &gatewayv1a2.HTTPRouteTimeouts{
+				Request:        ptrTo(gatewayv1a2.Duration("1s")),
+				BackendRequest: ptrTo(gatewayv1a2.Duration("2s")),
+			}
+		},
+		expectErrsOnFields: []string{"spec.rules[0].timeouts.backendRequest"},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := &gatewayv1a2.HTTPRoute{
+				Spec: gatewayv1a2.HTTPRouteSpec{
+					Rules: []gatewayv1a2.HTTPRouteRule{{}},
+				},
+			}
+			tc.mutate(&route.Spec)
+
+			errs := ValidateHTTPRoute(route)
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			assert.Equal(t, tc.expectErrsOnFields, fields, "errors: %v", errs)
+		})
+	}
+}
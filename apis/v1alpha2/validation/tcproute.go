@@ -32,15 +32,11 @@ func ValidateTCPRoute(route *gatewayv1a2.TCPRoute) field.ErrorList {
 // validateTCPRouteSpec validates that required fields of spec are set according to the
 // TCPRoute specification.
 func validateTCPRouteSpec(spec *gatewayv1a2.TCPRouteSpec, path *field.Path) field.ErrorList {
-	path = path.Child("rules")
 	var errs field.ErrorList
+	errs = append(errs, validateRouteRulesCount(len(spec.Rules), path.Child("rules"))...)
 	for i, rule := range spec.Rules {
-		for j, ref := range rule.BackendRefs {
-			// Avoid referencing to the loop variable.
-			ref := ref
-			errs = append(errs, validateBackendRefServicePort(&ref, path.Index(i).Child("backendRefs").Index(j))...)
-			errs = append(errs, validateParentRefs(spec.ParentRefs, path.Child("spec"))...)
-		}
+		errs = append(errs, validateBackendRefs(rule.BackendRefs, path.Child("rules").Index(i).Child("backendRefs"))...)
 	}
+	errs = append(errs, ValidateParentRefs(spec.ParentRefs, path)...)
 	return errs
 }
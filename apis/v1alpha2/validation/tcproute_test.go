@@ -28,6 +28,10 @@ func TestValidateTCPRoute(t *testing.T) {
 	t.Parallel()
 
 	portNumber := gatewayv1a2.PortNumber(9080)
+	tooManyPorts := make([]*int32, 17)
+	for i := range tooManyPorts {
+		tooManyPorts[i] = (*int32)(&portNumber)
+	}
 
 	tests := []struct {
 		name  string
@@ -69,6 +73,71 @@ func TestValidateTCPRoute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid TCPRoute without rules",
+			errs: field.ErrorList{
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "spec.rules",
+					Detail: "must specify at least one rule",
+				},
+			},
+		},
+		{
+			name:  "invalid TCPRoute rule without backendRefs",
+			rules: []gatewayv1a2.TCPRouteRule{{}},
+			errs: field.ErrorList{
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "spec.rules[0].backendRefs",
+					Detail: "must specify at least one backendRef",
+				},
+			},
+		},
+		{
+			name:  "invalid TCPRoute with too many rules",
+			rules: makeRouteRules[gatewayv1a2.TCPRouteRule](tooManyPorts...),
+			errs: field.ErrorList{
+				field.TooMany(field.NewPath("spec", "rules"), 17, 16),
+			},
+		},
+		{
+			name: "valid TCPRoute with a zero and a non-zero weight",
+			rules: []gatewayv1a2.TCPRouteRule{
+				{
+					BackendRefs: []gatewayv1a2.BackendRef{
+						{
+							BackendObjectReference: gatewayv1a2.BackendObjectReference{Port: &portNumber},
+							Weight:                 ptrTo(int32(0)),
+						},
+						{
+							BackendObjectReference: gatewayv1a2.BackendObjectReference{Port: &portNumber},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid TCPRoute with only zero or out of range weights",
+			rules: []gatewayv1a2.TCPRouteRule{
+				{
+					BackendRefs: []gatewayv1a2.BackendRef{
+						{
+							BackendObjectReference: gatewayv1a2.BackendObjectReference{Port: &portNumber},
+							Weight:                 ptrTo(int32(0)),
+						},
+						{
+							BackendObjectReference: gatewayv1a2.BackendObjectReference{Port: &portNumber},
+							Weight:                 ptrTo(int32(-1)),
+						},
+					},
+				},
+			},
+			errs: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "rules").Index(0).Child("backendRefs").Index(1).Child("weight"), int32(-1), "must be between 0 and 1000000"),
+				field.Invalid(field.NewPath("spec", "rules").Index(0).Child("backendRefs"), 0, "at least one backendRef must have a non-zero weight"),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -93,3 +162,24 @@ func TestValidateTCPRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTCPRouteParentRefs(t *testing.T) {
+	portNumber := gatewayv1a2.PortNumber(9080)
+	route := gatewayv1a2.TCPRoute{
+		Spec: gatewayv1a2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1a2.CommonRouteSpec{
+				ParentRefs: []gatewayv1a2.ParentReference{{Name: "gateway"}, {Name: "gateway"}},
+			},
+			Rules: []gatewayv1a2.TCPRouteRule{{
+				BackendRefs: []gatewayv1a2.BackendRef{{
+					BackendObjectReference: gatewayv1a2.BackendObjectReference{Port: &portNumber},
+				}},
+			}},
+		},
+	}
+
+	errs := ValidateTCPRoute(&route)
+	if len(errs) != 1 || errs[0].Field != "spec.parentRefs" {
+		t.Fatalf("expected a single error on spec.parentRefs, got: %s", errs)
+	}
+}
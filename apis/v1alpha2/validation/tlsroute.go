@@ -33,15 +33,11 @@ func ValidateTLSRoute(route *gatewayv1a2.TLSRoute) field.ErrorList {
 // TLSRoute specification.
 func validateTLSRouteSpec(spec *gatewayv1a2.TLSRouteSpec, path *field.Path) field.ErrorList {
 	var errs field.ErrorList
-	path = path.Child("rules")
-
+	errs = append(errs, validateSNIHostnames(spec.Hostnames, path.Child("hostnames"))...)
+	errs = append(errs, validateRouteRulesCount(len(spec.Rules), path.Child("rules"))...)
 	for i, rule := range spec.Rules {
-		for j, ref := range rule.BackendRefs {
-			// Avoid referencing to the loop variable.
-			ref := ref
-			errs = append(errs, validateBackendRefServicePort(&ref, path.Index(i).Child("backendRefs").Index(j))...)
-			errs = append(errs, validateParentRefs(spec.ParentRefs, path.Child("spec"))...)
-		}
+		errs = append(errs, validateBackendRefs(rule.BackendRefs, path.Child("rules").Index(i).Child("backendRefs"))...)
 	}
+	errs = append(errs, ValidateParentRefs(spec.ParentRefs, path)...)
 	return errs
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -71,3 +72,88 @@ func TestValidateTLSRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTLSRouteHostnames(t *testing.T) {
+	t.Parallel()
+
+	var portNumber int32 = 9080
+
+	tests := []struct {
+		name      string
+		hostnames []gatewayv1a2.Hostname
+		errs      field.ErrorList
+	}{
+		{
+			name:      "valid precise and wildcard hostnames",
+			hostnames: []gatewayv1a2.Hostname{"foo.example.com", "*.example.com", "example"},
+		},
+		{
+			name:      "invalid IP addresses",
+			hostnames: []gatewayv1a2.Hostname{"192.168.1.1", "2001:db8::1"},
+			errs: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "hostnames").Index(0), gatewayv1a2.Hostname("192.168.1.1"), "must not be an IP address"),
+				field.Invalid(field.NewPath("spec", "hostnames").Index(1), gatewayv1a2.Hostname("2001:db8::1"), "must not be an IP address"),
+			},
+		},
+		{
+			name:      "invalid hostname with port",
+			hostnames: []gatewayv1a2.Hostname{"foo.example.com:443"},
+			errs: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "hostnames").Index(0), gatewayv1a2.Hostname("foo.example.com:443"), "must not contain a port"),
+			},
+		},
+		{
+			name:      "invalid wildcards",
+			hostnames: []gatewayv1a2.Hostname{"*", "foo.*.example.com", "*foo.example.com"},
+			errs: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "hostnames").Index(0), gatewayv1a2.Hostname("*"), "wildcard must be the first label, followed by a dot, e.g. *.example.com"),
+				field.Invalid(field.NewPath("spec", "hostnames").Index(1), gatewayv1a2.Hostname("foo.*.example.com"), "wildcard must be the first label, followed by a dot, e.g. *.example.com"),
+				field.Invalid(field.NewPath("spec", "hostnames").Index(2), gatewayv1a2.Hostname("*foo.example.com"), "wildcard must be the first label, followed by a dot, e.g. *.example.com"),
+			},
+		},
+		{
+			name:      "invalid characters",
+			hostnames: []gatewayv1a2.Hostname{"Foo.example.com"},
+			errs: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "hostnames").Index(0), gatewayv1a2.Hostname("Foo.example.com"),
+					"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		{
+			name:      "too many hostnames",
+			hostnames: manyHostnames(17),
+			errs:      field.ErrorList{field.TooMany(field.NewPath("spec", "hostnames"), 17, 16)},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			route := gatewayv1a2.TLSRoute{Spec: gatewayv1a2.TLSRouteSpec{
+				Hostnames: tc.hostnames,
+				Rules:     makeRouteRules[gatewayv1a2.TLSRouteRule](&portNumber),
+			}}
+			errs := ValidateTLSRoute(&route)
+			if len(errs) != len(tc.errs) {
+				t.Fatalf("got %d errors, want %d errors: %s", len(errs), len(tc.errs), errs)
+			}
+			for i := 0; i < len(errs); i++ {
+				realErr := errs[i].Error()
+				expectedErr := tc.errs[i].Error()
+				if realErr != expectedErr {
+					t.Fatalf("expect error message: %s, but got: %s", expectedErr, realErr)
+				}
+			}
+		})
+	}
+}
+
+func manyHostnames(n int) []gatewayv1a2.Hostname {
+	hostnames := make([]gatewayv1a2.Hostname, 0, n)
+	for i := 0; i < n; i++ {
+		hostnames = append(hostnames, gatewayv1a2.Hostname(fmt.Sprintf("host-%d.example.com", i)))
+	}
+	return hostnames
+}
@@ -49,6 +49,17 @@ func TestValidateUDPRoute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "invalid UDPRoute rule without backendRefs",
+			rules: []gatewayv1a2.UDPRouteRule{{}},
+			errs: field.ErrorList{
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "spec.rules[0].backendRefs",
+					Detail: "must specify at least one backendRef",
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -22,7 +22,7 @@ import (
 )
 
 type routeRule interface {
-	gatewayv1a2.TLSRouteRule | gatewayv1a2.UDPRouteRule
+	gatewayv1a2.TCPRouteRule | gatewayv1a2.TLSRouteRule | gatewayv1a2.UDPRouteRule
 }
 
 func makeRouteRules[T routeRule](ports ...*int32) (rules []T) {
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const fuzzIterations = 100
+
+func TestRoundTripConversions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, AddToScheme(scheme))
+	require.NoError(t, v1.AddToScheme(scheme))
+
+	for _, tc := range []struct {
+		name       string
+		spoke, hub func() runtime.Object
+	}{
+		{"GatewayClass", func() runtime.Object { return &GatewayClass{} }, func() runtime.Object { return &v1.GatewayClass{} }},
+		{"GatewayClassList", func() runtime.Object { return &GatewayClassList{} }, func() runtime.Object { return &v1.GatewayClassList{} }},
+		{"Gateway", func() runtime.Object { return &Gateway{} }, func() runtime.Object { return &v1.Gateway{} }},
+		{"GatewayList", func() runtime.Object { return &GatewayList{} }, func() runtime.Object { return &v1.GatewayList{} }},
+		{"HTTPRoute", func() runtime.Object { return &HTTPRoute{} }, func() runtime.Object { return &v1.HTTPRoute{} }},
+		{"HTTPRouteList", func() runtime.Object { return &HTTPRouteList{} }, func() runtime.Object { return &v1.HTTPRouteList{} }},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// TypeMetas are rewritten by the conversion, list items are expected
+			// to have empty ones.
+			f := fuzz.New().NilChance(0.2).NumElements(0, 3).Funcs(func(tm *metav1.TypeMeta, _ fuzz.Continue) {
+				*tm = metav1.TypeMeta{}
+			})
+			for i := 0; i < fuzzIterations; i++ {
+				original := tc.spoke()
+				f.Fuzz(original)
+				original.GetObjectKind().SetGroupVersionKind(SchemeGroupVersion.WithKind(tc.name))
+
+				hub := tc.hub()
+				require.NoError(t, scheme.Convert(original, hub, nil))
+				assert.Equal(t, tc.name, hub.GetObjectKind().GroupVersionKind().Kind)
+				assert.NotEqual(t, SchemeGroupVersion, hub.GetObjectKind().GroupVersionKind().GroupVersion())
+
+				roundTripped := tc.spoke()
+				require.NoError(t, scheme.Convert(hub, roundTripped, nil))
+				if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+					t.Fatalf("round trip through the hub version changed the object:\n%#v\n%#v", original, roundTripped)
+				}
+			}
+		})
+	}
+}
+
+func TestConversionDoesNotShareMemory(t *testing.T) {
+	hostname := v1.Hostname("foo.example.com")
+	route := &HTTPRoute{Spec: HTTPRouteSpec{Hostnames: []Hostname{hostname}}}
+
+	var out v1.HTTPRoute
+	require.NoError(t, ConvertHTTPRouteToV1(route, &out))
+	out.Spec.Hostnames[0] = "bar.example.com"
+
+	assert.Equal(t, hostname, route.Spec.Hostnames[0])
+	assert.Empty(t, out.APIVersion, "empty TypeMeta must be kept empty")
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// ObjectRef identifies an object taking part in a reference, either as the
+// source holding the reference or as its target. The empty group is the
+// Kubernetes core API group. Name may be left empty for sources, whose name
+// is not considered by ReferenceGrants.
+type ObjectRef struct {
+	Group     gatewayv1b1.Group
+	Kind      gatewayv1b1.Kind
+	Namespace gatewayv1b1.Namespace
+	Name      gatewayv1b1.ObjectName
+}
+
+// BackendRefTarget returns the target of a BackendObjectReference held by an
+// object in namespace, defaulting the kind to Service and the namespace to
+// the namespace of the source.
+func BackendRefTarget(namespace gatewayv1b1.Namespace, ref gatewayv1b1.BackendObjectReference) ObjectRef {
+	return objectRefTarget(namespace, ref.Group, ref.Kind, ref.Namespace, ref.Name, "Service")
+}
+
+// SecretRefTarget returns the target of a SecretObjectReference held by an
+// object in namespace, defaulting the kind to Secret and the namespace to the
+// namespace of the source.
+func SecretRefTarget(namespace gatewayv1b1.Namespace, ref gatewayv1b1.SecretObjectReference) ObjectRef {
+	return objectRefTarget(namespace, ref.Group, ref.Kind, ref.Namespace, ref.Name, "Secret")
+}
+
+func objectRefTarget(namespace gatewayv1b1.Namespace, group *gatewayv1b1.Group, kind *gatewayv1b1.Kind, refNamespace *gatewayv1b1.Namespace, name gatewayv1b1.ObjectName, defaultKind gatewayv1b1.Kind) ObjectRef {
+	target := ObjectRef{Kind: defaultKind, Namespace: namespace, Name: name}
+	if group != nil {
+		target.Group = *group
+	}
+	if kind != nil {
+		target.Kind = *kind
+	}
+	if refNamespace != nil {
+		target.Namespace = *refNamespace
+	}
+	return target
+}
+
+// IsReferenceAllowed evaluates whether from may reference to according to
+// the ReferenceGrant semantics. References within a namespace are always
+// allowed and return a nil grant. Cross-namespace references are allowed when
+// one of the grants lives in the namespace of the target and lists both the
+// source in From and the target in To. The first matching grant is
+// returned.
+//
+// Grants in other namespaces than the target namespace are ignored, so
+// callers may pass all the ReferenceGrants of a cluster.
+func IsReferenceAllowed(from, to ObjectRef, grants []gatewayv1b1.ReferenceGrant) (*gatewayv1b1.ReferenceGrant, bool) {
+	if from.Namespace == to.Namespace {
+		return nil, true
+	}
+	for i := range grants {
+		grant := &grants[i]
+		if gatewayv1b1.Namespace(grant.Namespace) != to.Namespace {
+			continue
+		}
+		if grantAllowsFrom(grant, from) && grantAllowsTo(grant, to) {
+			return grant, true
+		}
+	}
+	return nil, false
+}
+
+func grantAllowsFrom(grant *gatewayv1b1.ReferenceGrant, from ObjectRef) bool {
+	for _, f := range grant.Spec.From {
+		if f.Group == from.Group && f.Kind == from.Kind && f.Namespace == from.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func grantAllowsTo(grant *gatewayv1b1.ReferenceGrant, to ObjectRef) bool {
+	for _, t := range grant.Spec.To {
+		if t.Group == to.Group && t.Kind == to.Kind && (t.Name == nil || *t.Name == "" || *t.Name == to.Name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	validationutils "sigs.k8s.io/gateway-api/apis/v1beta1/util/validation"
+)
+
+func TestIsReferenceAllowed(t *testing.T) {
+	backendName := gatewayv1b1.ObjectName("backend")
+	grants := []gatewayv1b1.ReferenceGrant{{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "unrelated"},
+		Spec: gatewayv1b1.ReferenceGrantSpec{
+			From: []gatewayv1b1.ReferenceGrantFrom{{Group: gatewayv1b1.GroupName, Kind: "HTTPRoute", Namespace: "routes"}},
+			To:   []gatewayv1b1.ReferenceGrantTo{{Kind: "Service"}},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "routes-to-backend", Namespace: "backends"},
+		Spec: gatewayv1b1.ReferenceGrantSpec{
+			From: []gatewayv1b1.ReferenceGrantFrom{
+				{Group: gatewayv1b1.GroupName, Kind: "GRPCRoute", Namespace: "routes"},
+				{Group: gatewayv1b1.GroupName, Kind: "HTTPRoute", Namespace: "routes"},
+			},
+			To: []gatewayv1b1.ReferenceGrantTo{{Kind: "Service", Name: &backendName}},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "gateways-to-secrets", Namespace: "certificates"},
+		Spec: gatewayv1b1.ReferenceGrantSpec{
+			From: []gatewayv1b1.ReferenceGrantFrom{{Group: gatewayv1b1.GroupName, Kind: "Gateway", Namespace: "gateways"}},
+			To:   []gatewayv1b1.ReferenceGrantTo{{Kind: "Secret"}},
+		},
+	}}
+
+	httpRoute := validationutils.ObjectRef{Group: gatewayv1b1.GroupName, Kind: "HTTPRoute", Namespace: "routes"}
+	gateway := validationutils.ObjectRef{Group: gatewayv1b1.GroupName, Kind: "Gateway", Namespace: "gateways"}
+
+	backendNamespace := gatewayv1b1.Namespace("backends")
+	secretNamespace := gatewayv1b1.Namespace("certificates")
+	configMapKind := gatewayv1b1.Kind("ConfigMap")
+
+	testCases := []struct {
+		name      string
+		from      validationutils.ObjectRef
+		to        validationutils.ObjectRef
+		wantGrant string
+		wantOK    bool
+	}{{
+		name:   "same namespace",
+		from:   httpRoute,
+		to:     validationutils.BackendRefTarget("routes", gatewayv1b1.BackendObjectReference{Name: "local"}),
+		wantOK: true,
+	}, {
+		name:      "backend allowed by name",
+		from:      httpRoute,
+		to:        validationutils.BackendRefTarget("routes", gatewayv1b1.BackendObjectReference{Name: "backend", Namespace: &backendNamespace}),
+		wantGrant: "routes-to-backend",
+		wantOK:    true,
+	}, {
+		name: "other backend in the namespace",
+		from: httpRoute,
+		to:   validationutils.BackendRefTarget("routes", gatewayv1b1.BackendObjectReference{Name: "other", Namespace: &backendNamespace}),
+	}, {
+		name: "kind not listed in from",
+		from: validationutils.ObjectRef{Group: gatewayv1b1.GroupName, Kind: "TCPRoute", Namespace: "routes"},
+		to:   validationutils.BackendRefTarget("routes", gatewayv1b1.BackendObjectReference{Name: "backend", Namespace: &backendNamespace}),
+	}, {
+		name: "namespace not listed in from",
+		from: validationutils.ObjectRef{Group: gatewayv1b1.GroupName, Kind: "HTTPRoute", Namespace: "other"},
+		to:   validationutils.BackendRefTarget("other", gatewayv1b1.BackendObjectReference{Name: "backend", Namespace: &backendNamespace}),
+	}, {
+		name:      "secret allowed for every name",
+		from:      gateway,
+		to:        validationutils.SecretRefTarget("gateways", gatewayv1b1.SecretObjectReference{Name: "cert", Namespace: &secretNamespace}),
+		wantGrant: "gateways-to-secrets",
+		wantOK:    true,
+	}, {
+		name: "kind not listed in to",
+		from: gateway,
+		to: validationutils.SecretRefTarget("gateways", gatewayv1b1.SecretObjectReference{
+			Kind: &configMapKind, Name: "cert", Namespace: &secretNamespace,
+		}),
+	}, {
+		name: "grants in other namespaces than the target are ignored",
+		from: httpRoute,
+		to:   validationutils.ObjectRef{Kind: "Service", Namespace: "elsewhere", Name: "backend"},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			grant, ok := validationutils.IsReferenceAllowed(tc.from, tc.to, grants)
+			assert.Equal(t, tc.wantOK, ok)
+			if tc.wantGrant == "" {
+				assert.Nil(t, grant)
+			} else if assert.NotNil(t, grant) {
+				assert.Equal(t, tc.wantGrant, grant.Name)
+			}
+		})
+	}
+}
+
+func TestRefTargetDefaults(t *testing.T) {
+	group := gatewayv1b1.Group("example.com")
+	kind := gatewayv1b1.Kind("Bucket")
+
+	assert.Equal(t, validationutils.ObjectRef{Kind: "Service", Namespace: "ns", Name: "svc"},
+		validationutils.BackendRefTarget("ns", gatewayv1b1.BackendObjectReference{Name: "svc"}))
+	assert.Equal(t, validationutils.ObjectRef{Group: "example.com", Kind: "Bucket", Namespace: "ns", Name: "b"},
+		validationutils.BackendRefTarget("ns", gatewayv1b1.BackendObjectReference{Group: &group, Kind: &kind, Name: "b"}))
+	assert.Equal(t, validationutils.ObjectRef{Kind: "Secret", Namespace: "ns", Name: "cert"},
+		validationutils.SecretRefTarget("ns", gatewayv1b1.SecretObjectReference{Name: "cert"}))
+}
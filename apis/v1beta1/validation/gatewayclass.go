@@ -19,6 +19,8 @@ package validation
 import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	gatewayvalidation "sigs.k8s.io/gateway-api/apis/internal/validation"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
@@ -26,13 +28,5 @@ import (
 // Gateway API specification. For additional details of the GatewayClass spec, refer to:
 // https://gateway-api.sigs.k8s.io/v1beta1/reference/spec/#gateway.networking.k8s.io/v1beta1.GatewayClass
 func ValidateGatewayClassUpdate(oldClass, newClass *gatewayv1b1.GatewayClass) field.ErrorList {
-	if oldClass == nil || newClass == nil {
-		return nil
-	}
-	var errs field.ErrorList
-	if oldClass.Spec.ControllerName != newClass.Spec.ControllerName {
-		errs = append(errs, field.Invalid(field.NewPath("spec.controllerName"), newClass.Spec.ControllerName,
-			"cannot update an immutable field"))
-	}
-	return errs
+	return gatewayvalidation.ValidateGatewayClassUpdate((*gatewayv1.GatewayClass)(oldClass), (*gatewayv1.GatewayClass)(newClass))
 }
@@ -1283,6 +1283,27 @@ func TestValidateHTTPTimeouts(t *testing.T) {
 					},
 				},
 			},
+		}, {
+			name:     "invalid httpRoute Rules request timeout with fractional value",
+			errCount: 1,
+			rules: []gatewayv1b1.HTTPRouteRule{
+				{
+					Timeouts: &gatewayv1b1.HTTPRouteTimeouts{
+						Request: toDuration("1.5s"),
+					},
+				},
+			},
+		}, {
+			name:     "invalid httpRoute Rules backendRequest timeout with unsupported unit",
+			errCount: 1,
+			rules: []gatewayv1b1.HTTPRouteRule{
+				{
+					Timeouts: &gatewayv1b1.HTTPRouteTimeouts{
+						Request:        toDuration("1h"),
+						BackendRequest: toDuration("1d"),
+					},
+				},
+			},
 		}, {
 			name:     "valid httpRoute Rules request 0s (infinite) and backendRequest 100ms",
 			errCount: 0,
@@ -1306,3 +1327,36 @@ func TestValidateHTTPTimeouts(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHTTPRouteParentRefs(t *testing.T) {
+	tests := []struct {
+		name       string
+		parentRefs []gatewayv1b1.ParentReference
+		errFields  []string
+	}{{
+		name: "duplicate ports are reported under spec.parentRefs",
+		parentRefs: []gatewayv1b1.ParentReference{
+			{Name: "gateway", Port: ptrTo(gatewayv1b1.PortNumber(80))},
+			{Name: "gateway", Port: ptrTo(gatewayv1b1.PortNumber(80))},
+		},
+		errFields: []string{"spec.parentRefs[1].port"},
+	}, {
+		name: "missing sectionNames or ports are reported under spec.parentRefs",
+		parentRefs: []gatewayv1b1.ParentReference{
+			{Name: "gateway"},
+			{Name: "gateway"},
+		},
+		errFields: []string{"spec.parentRefs"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := gatewayv1b1.HTTPRoute{Spec: gatewayv1b1.HTTPRouteSpec{CommonRouteSpec: gatewayv1b1.CommonRouteSpec{ParentRefs: tc.parentRefs}}}
+			errs := ValidateHTTPRoute(&route)
+			var errFields []string
+			for _, err := range errs {
+				errFields = append(errFields, err.Field)
+			}
+			assert.Equal(t, tc.errFields, errFields, errs)
+		})
+	}
+}
@@ -22,20 +22,30 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/gateway-api/pkg/admission"
+	"sigs.k8s.io/gateway-api/pkg/admission/policy"
 )
 
 var (
 	tlsCertFilePath, tlsKeyFilePath string
+	address                         string
+	policyConfigFilePath            string
+	port                            int
+	validateRegularExpressions      bool
 	showVersion, help               bool
 )
 
@@ -45,8 +55,16 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		os.Exit(runValidate(os.Args[2:], os.Stdout))
+	}
+
 	flag.StringVar(&tlsCertFilePath, "tlsCertFile", "/etc/certs/tls.crt", "File with x509 certificate")
 	flag.StringVar(&tlsKeyFilePath, "tlsKeyFile", "/etc/certs/tls.key", "File with private key to tlsCertFile")
+	flag.StringVar(&address, "address", "", "IP address the server listens on, all interfaces if empty")
+	flag.IntVar(&port, "port", 8443, "Port the server listens on")
+	flag.StringVar(&policyConfigFilePath, "policyConfigFile", "", "File with policy rules enforced in addition to the Gateway API validation")
+	flag.BoolVar(&validateRegularExpressions, "validateRegularExpressions", false, "Reject invalid RE2 regular expressions in HTTPRoute matches and warn about ambiguous or expensive ones")
 	flag.BoolVar(&showVersion, "version", false, "Show release version and exit")
 	flag.BoolVar(&help, "help", false, "Show flag defaults and exit")
 	klog.InitFlags(nil)
@@ -60,36 +78,78 @@ func main() {
 	if help {
 		printVersion()
 		flag.PrintDefaults()
+		fmt.Println("\nSubcommands:\n  validate\tValidate manifest files offline, see validate -h")
 		os.Exit(0)
 	}
 
 	printVersion()
 
-	certs, err := tls.LoadX509KeyPair(tlsCertFilePath, tlsKeyFilePath)
+	ctrllog.SetLogger(klog.NewKlogr())
+
+	if policyConfigFilePath != "" {
+		p, err := policy.Load(policyConfigFilePath)
+		if err != nil {
+			klog.Fatalf("failed to load policy configuration: %v", err)
+		}
+		admission.SetPolicy(p)
+	}
+	admission.SetRegularExpressionValidation(validateRegularExpressions)
+
+	// The certificate and key are reloaded whenever the files change so that
+	// rotated certificates are picked up without restarting the server.
+	watcher, err := certwatcher.New(tlsCertFilePath, tlsKeyFilePath)
 	if err != nil {
 		klog.Fatalf("failed to load TLS cert-key for admission-webhook-server: %v", err)
 	}
+	watcher.RegisterCallback(admission.ObserveCertificate)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		if err := watcher.Start(ctx); err != nil {
+			klog.Errorf("failed to watch TLS cert-key for admission-webhook-server: %v", err)
+		}
+	}()
 
+	listenAddr := net.JoinHostPort(address, strconv.Itoa(port))
 	server := &http.Server{
-		Addr:              ":8443",
+		Addr:              listenAddr,
 		ReadHeaderTimeout: 10 * time.Second, // for Potential Slowloris Attack (G112)
 		// Require at least TLS12 to satisfy golint G402.
-		TLSConfig: &tls.Config{MinVersion: tls.VersionTLS12, Certificates: []tls.Certificate{certs}},
+		TLSConfig: &tls.Config{MinVersion: tls.VersionTLS12, GetCertificate: watcher.GetCertificate},
 	}
+	var ready atomic.Bool
 	mux := http.NewServeMux()
 	mux.HandleFunc("/validate", admission.ServeHTTP)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !ready.Load() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.Handle("/metrics", admission.MetricsHandler())
 	server.Handler = mux
 
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		klog.Fatalf("failed to listen on %s: %v", listenAddr, err)
+	}
+
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err := server.ListenAndServeTLS("", "")
+		err := server.ServeTLS(listener, "", "")
 		if errors.Is(err, http.ErrServerClosed) {
 			klog.Errorf("admission-webhook-server stopped: %v", err)
 		}
 	}()
-	klog.Info("admission webhook server started and listening on :8443")
+	ready.Store(true)
+	klog.Infof("admission webhook server started and listening on %s", listenAddr)
 
 	// gracefully shutdown
 	signalChan := make(chan os.Signal, 1)
@@ -97,6 +157,8 @@ func main() {
 	<-signalChan
 
 	klog.Info("admission webhook received kill signal")
+	ready.Store(false)
+	cancel()
 	if err := server.Shutdown(context.Background()); err != nil {
 		klog.Errorf("server shutdown failed:%+v", err)
 	}
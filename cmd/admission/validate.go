@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/gateway-api/pkg/admission"
+	"sigs.k8s.io/gateway-api/pkg/admission/policy"
+)
+
+// stringSliceFlag is a flag that can be repeated.
+type stringSliceFlag []string
+
+func (s *stringSliceFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSliceFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// runValidate validates manifest files offline with the same validation as
+// the webhook and returns the exit code of the command.
+func runValidate(args []string, out io.Writer) int {
+	var (
+		files      stringSliceFlag
+		policyFile string
+		regex      bool
+	)
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.Var(&files, "f", "Manifest file or directory to validate, may be repeated")
+	fs.StringVar(&policyFile, "policyConfigFile", "", "File with policy rules enforced in addition to the Gateway API validation")
+	fs.BoolVar(&regex, "validateRegularExpressions", false, "Reject invalid RE2 regular expressions in HTTPRoute matches and warn about ambiguous or expensive ones")
+	fs.Usage = func() {
+		fmt.Fprintf(out, "Usage: %s validate -f <file|dir> [-f <file|dir>...]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	if policyFile != "" {
+		p, err := policy.Load(policyFile)
+		if err != nil {
+			fmt.Fprintf(out, "failed to load policy configuration: %v\n", err)
+			return 2
+		}
+		admission.SetPolicy(p)
+	}
+	admission.SetRegularExpressionValidation(regex)
+
+	var validated, invalid int
+	for _, f := range files {
+		manifests, err := admission.ReadManifests(f)
+		if err != nil {
+			// the errors of the files that couldn't be read are reported, the
+			// manifests read from the other files are still validated.
+			for _, err := range joinedErrors(err) {
+				fmt.Fprintf(out, "%v\n", err)
+				invalid++
+			}
+		}
+		for i := range manifests {
+			m := &manifests[i]
+			errs, warnings, err := admission.ValidateObject(m.Raw)
+			if errors.Is(err, admission.ErrUnsupportedKind) {
+				continue
+			}
+			validated++
+			if err != nil {
+				fmt.Fprintf(out, "%s:%d: %v\n", m.File, m.Line, err)
+				invalid++
+				continue
+			}
+			name := objectName(m.Raw)
+			for _, w := range warnings {
+				fmt.Fprintf(out, "%s:%d: %s: warning: %s\n", m.File, m.Line, name, w)
+			}
+			for _, e := range errs {
+				fmt.Fprintf(out, "%s:%d: %s: %v\n", m.File, m.FieldLine(e.Field), name, e)
+			}
+			if len(errs) > 0 {
+				invalid++
+			}
+		}
+	}
+
+	fmt.Fprintf(out, "%d objects validated, %d invalid\n", validated, invalid)
+	if invalid > 0 {
+		return 1
+	}
+	return 0
+}
+
+// joinedErrors returns the errors joined in err, or err itself.
+func joinedErrors(err error) []error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+// objectName formats the kind, namespace and name of an object.
+func objectName(raw []byte) string {
+	var obj meta.PartialObjectMetadata
+	// The object was already successfully decoded during validation.
+	_ = json.Unmarshal(raw, &obj)
+	if obj.Namespace == "" {
+		return fmt.Sprintf("%s %s", obj.Kind, obj.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", obj.Kind, obj.Namespace, obj.Name)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/report"
+)
+
+// runDiff compares two conformance reports and returns the exit code of the
+// command: 1 if the new report has regressions, 2 on errors.
+func runDiff(args []string, out io.Writer) int {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.Usage = func() {
+		fmt.Fprintf(out, "Usage: %s diff <old.yaml> <new.yaml>\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+
+	oldReport, err := report.Load(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(out, "failed to load report: %v\n", err)
+		return 2
+	}
+	newReport, err := report.Load(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(out, "failed to load report: %v\n", err)
+		return 2
+	}
+
+	diff := report.Compare(oldReport, newReport)
+	if err := diff.Print(out); err != nil {
+		fmt.Fprintf(out, "failed to print the changes: %v\n", err)
+		return 2
+	}
+	if diff.HasRegressions() {
+		fmt.Fprintln(out, "Regressions found")
+		return 1
+	}
+	return 0
+}
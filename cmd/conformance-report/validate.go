@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/report"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+// defaultReportsDir is where implementations submit their reports.
+const defaultReportsDir = "conformance/reports"
+
+// runValidate validates conformance report files, or the reports found in
+// directories, and returns the exit code of the command: 1 if any report is
+// invalid, 2 on errors.
+func runValidate(args []string, out io.Writer) int {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	flags.SetOutput(out)
+	flags.Usage = func() {
+		fmt.Fprintf(out, "Usage: %s validate [<file|dir>...]\n\nValidates the reports in %s when no file or directory is given.\n", os.Args[0], defaultReportsDir)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{defaultReportsDir}
+	}
+
+	var files []string
+	for _, path := range paths {
+		found, err := reportFiles(path)
+		if err != nil {
+			fmt.Fprintf(out, "%v\n", err)
+			return 2
+		}
+		files = append(files, found...)
+	}
+
+	var invalid int
+	for _, file := range files {
+		r, err := report.LoadStrict(file)
+		if err != nil {
+			fmt.Fprintf(out, "%v\n", err)
+			invalid++
+			continue
+		}
+		errs := suite.ValidateConformanceReport(r)
+		for _, e := range errs {
+			fmt.Fprintf(out, "%s: %v\n", file, e)
+		}
+		if len(errs) > 0 {
+			invalid++
+		}
+	}
+
+	fmt.Fprintf(out, "%d reports validated, %d invalid\n", len(files), invalid)
+	if invalid > 0 {
+		return 1
+	}
+	return 0
+}
+
+// reportFiles returns the path if it is a file, or the YAML files found in
+// the directory.
+func reportFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && (strings.HasSuffix(p, ".yaml") || strings.HasSuffix(p, ".yml")) {
+			files = append(files, p)
+		}
+		return nil
+	})
+	return files, err
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+// GroupVersion is the apiVersion of v1alpha2 conformance reports.
+const GroupVersion = "gateway.networking.k8s.io/v1alpha2"
+
+// ConvertFromV1Alpha1 converts a v1alpha1 ConformanceReport to v1alpha2.
+// v1alpha1 reports only name the failed and skipped tests, so the converted
+// test results have no duration and passed tests are not listed. The
+// environment and mode of the test run are left empty.
+func ConvertFromV1Alpha1(in *v1alpha1.ConformanceReport) *ConformanceReport {
+	out := &ConformanceReport{
+		TypeMeta: in.TypeMeta,
+		Implementation: Implementation{
+			Organization: in.Organization,
+			Project:      in.Project,
+			URL:          in.URL,
+			Version:      in.Version,
+			Contact:      append([]string(nil), in.Contact...),
+		},
+		Date:              in.Date,
+		GatewayAPIVersion: in.GatewayAPIVersion,
+	}
+	out.APIVersion = GroupVersion
+
+	for _, profile := range in.ProfileReports {
+		outProfile := ProfileReport{
+			Name: profile.Name,
+			Core: convertStatusFromV1Alpha1(profile.Core),
+		}
+		if profile.Extended != nil {
+			outProfile.Extended = &ExtendedStatus{
+				Status:              convertStatusFromV1Alpha1(profile.Extended.Status),
+				SupportedFeatures:   append([]string(nil), profile.Extended.SupportedFeatures...),
+				UnsupportedFeatures: append([]string(nil), profile.Extended.UnsupportedFeatures...),
+			}
+		}
+		out.ProfileReports = append(out.ProfileReports, outProfile)
+	}
+
+	return out
+}
+
+func convertStatusFromV1Alpha1(in v1alpha1.Status) Status {
+	out := Status{
+		Result:  Result(in.Result),
+		Summary: in.Summary,
+		Statistics: Statistics{
+			Passed:  in.Passed,
+			Skipped: in.Skipped,
+			Failed:  in.Failed,
+		},
+		SkippedTests: append([]string(nil), in.SkippedTests...),
+		FailedTests:  append([]string(nil), in.FailedTests...),
+	}
+	for _, name := range in.FailedTests {
+		out.Tests = append(out.Tests, TestResult{Name: name, Result: TestFailed})
+	}
+	for _, name := range in.SkippedTests {
+		out.Tests = append(out.Tests, TestResult{Name: name, Result: TestSkipped})
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+func TestConvertFromV1Alpha1(t *testing.T) {
+	in := &v1alpha1.ConformanceReport{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1alpha1",
+			Kind:       "ConformanceReport",
+		},
+		Implementation: v1alpha1.Implementation{
+			Organization: "example",
+			Project:      "gateway",
+			URL:          "https://example.com/gateway",
+			Version:      "v1.0.0",
+			Contact:      []string{"@example/maintainers"},
+		},
+		Date:              "2023-11-01T10:00:00Z",
+		GatewayAPIVersion: "v1.0.0",
+		ProfileReports: []v1alpha1.ProfileReport{{
+			Name: "HTTP",
+			Core: v1alpha1.Status{
+				Result:      v1alpha1.Failure,
+				Statistics:  v1alpha1.Statistics{Passed: 30, Failed: 1},
+				FailedTests: []string{"HTTPRouteHeaderMatching"},
+			},
+			Extended: &v1alpha1.ExtendedStatus{
+				Status: v1alpha1.Status{
+					Result:       v1alpha1.Partial,
+					Statistics:   v1alpha1.Statistics{Passed: 2, Skipped: 1},
+					SkippedTests: []string{"HTTPRouteMethodMatching"},
+				},
+				SupportedFeatures:   []string{"HTTPRouteMethodMatching"},
+				UnsupportedFeatures: []string{"HTTPRouteQueryParamMatching"},
+			},
+		}},
+	}
+
+	expected := &ConformanceReport{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1alpha2",
+			Kind:       "ConformanceReport",
+		},
+		Implementation: Implementation{
+			Organization: "example",
+			Project:      "gateway",
+			URL:          "https://example.com/gateway",
+			Version:      "v1.0.0",
+			Contact:      []string{"@example/maintainers"},
+		},
+		Date:              "2023-11-01T10:00:00Z",
+		GatewayAPIVersion: "v1.0.0",
+		ProfileReports: []ProfileReport{{
+			Name: "HTTP",
+			Core: Status{
+				Result:      Failure,
+				Statistics:  Statistics{Passed: 30, Failed: 1},
+				FailedTests: []string{"HTTPRouteHeaderMatching"},
+				Tests:       []TestResult{{Name: "HTTPRouteHeaderMatching", Result: TestFailed}},
+			},
+			Extended: &ExtendedStatus{
+				Status: Status{
+					Result:       Partial,
+					Statistics:   Statistics{Passed: 2, Skipped: 1},
+					SkippedTests: []string{"HTTPRouteMethodMatching"},
+					Tests:        []TestResult{{Name: "HTTPRouteMethodMatching", Result: TestSkipped}},
+				},
+				SupportedFeatures:   []string{"HTTPRouteMethodMatching"},
+				UnsupportedFeatures: []string{"HTTPRouteQueryParamMatching"},
+			},
+		}},
+	}
+
+	assert.Equal(t, expected, ConvertFromV1Alpha1(in))
+}
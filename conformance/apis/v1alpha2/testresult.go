@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestResult is the result of a single conformance test.
+type TestResult struct {
+	// Name is the short name of the test.
+	Name string `json:"name"`
+
+	// Result is the conclusion of the test.
+	Result TestResultType `json:"result"`
+
+	// Duration is how long the test ran. It is unset for reports converted
+	// from v1alpha1, which did not record it.
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// SkipReason explains why a skipped test did not run.
+	SkipReason string `json:"skipReason,omitempty"`
+
+	// FailureMessage describes why a failed test did not succeed.
+	FailureMessage string `json:"failureMessage,omitempty"`
+}
+
+// TestResultType is the conclusion of a single conformance test.
+type TestResultType string
+
+var (
+	// TestPassed indicates that the test succeeded.
+	TestPassed TestResultType = "passed"
+
+	// TestFailed indicates that the test failed.
+	TestFailed TestResultType = "failed"
+
+	// TestSkipped indicates that the test was explicitly disabled in the
+	// test suite.
+	TestSkipped TestResultType = "skipped"
+)
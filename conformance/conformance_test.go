@@ -65,17 +65,20 @@ func TestConformance(t *testing.T) {
 		RestConfig: cfg,
 		// This clientset is needed in addition to the client only because
 		// controller-runtime client doesn't support non CRUD sub-resources yet (https://github.com/kubernetes-sigs/controller-runtime/issues/452).
-		Clientset:                  clientset,
-		GatewayClassName:           *flags.GatewayClassName,
-		Debug:                      *flags.ShowDebug,
-		CleanupBaseResources:       *flags.CleanupBaseResources,
-		SupportedFeatures:          supportedFeatures,
-		ExemptFeatures:             exemptFeatures,
-		EnableAllSupportedFeatures: *flags.EnableAllSupportedFeatures,
-		NamespaceLabels:            namespaceLabels,
-		NamespaceAnnotations:       namespaceAnnotations,
-		SkipTests:                  skipTests,
-		RunTest:                    *flags.RunTest,
+		Clientset:                         clientset,
+		GatewayClassName:                  *flags.GatewayClassName,
+		Debug:                             *flags.ShowDebug,
+		CleanupBaseResources:              *flags.CleanupBaseResources,
+		SupportedFeatures:                 supportedFeatures,
+		ExemptFeatures:                    exemptFeatures,
+		EnableAllSupportedFeatures:        *flags.EnableAllSupportedFeatures,
+		SupportedFeaturesFromGatewayClass: *flags.SupportedFeatures == suite.SupportedFeaturesFromGatewayClass,
+		NamespaceLabels:                   namespaceLabels,
+		NamespaceAnnotations:              namespaceAnnotations,
+		SkipTests:                         skipTests,
+		Parallelism:                       *flags.Parallelism,
+		DiagnosticsDir:                    *flags.DiagnosticsDir,
+		RunTest:                           *flags.RunTest,
 	})
 	cSuite.Setup(t)
 
@@ -86,6 +86,21 @@ func main() {
 		h2cPort = "3001"
 	}
 
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "3002"
+	}
+
+	tcpPort := os.Getenv("TCP_PORT")
+	if tcpPort == "" {
+		tcpPort = "3003"
+	}
+
+	udpPort := os.Getenv("UDP_PORT")
+	if udpPort == "" {
+		udpPort = "3004"
+	}
+
 	httpsPort := os.Getenv("HTTPS_PORT")
 	if httpsPort == "" {
 		httpsPort = "8443"
@@ -117,6 +132,12 @@ func main() {
 
 	go runH2CServer(h2cPort, errchan)
 
+	go runGRPCServer(grpcPort, errchan)
+
+	go runTCPServer(tcpPort, errchan)
+
+	go runUDPServer(udpPort, errchan)
+
 	// Enable HTTPS if certificate and private key are given.
 	if os.Getenv("TLS_SERVER_CERT") != "" && os.Getenv("TLS_SERVER_PRIVKEY") != "" {
 		go func() {
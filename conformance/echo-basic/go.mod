@@ -2,6 +2,15 @@ module sigs.k8s.io/gateway-api/conformance/echo-basic
 
 go 1.21
 
-require golang.org/x/net v0.17.0
+require (
+	golang.org/x/net v0.17.0
+	google.golang.org/grpc v1.59.0
+	google.golang.org/protobuf v1.31.0
+)
 
-require golang.org/x/text v0.13.0 // indirect
+require (
+	github.com/golang/protobuf v1.5.3 // indirect
+	golang.org/x/sys v0.13.0 // indirect
+	golang.org/x/text v0.13.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20230822172742-b8732ec3820d // indirect
+)
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	stdcontext "context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The gRPC echo service uses google.protobuf.Struct messages so that it can
+// be described without generated code. Its methods respond with the
+// assertions of the call:
+//
+//	{
+//	  "method": "/gateway_api_conformance.echo_basic.grpcecho.GrpcEcho/Echo",
+//	  "authority": "grpc.example.com",
+//	  "headers": {"x-header": ["value"]},
+//	  "namespace": "...", "ingress": "...", "service": "...", "pod": "...",
+//	  "sequence": 0
+//	}
+//
+// EchoStream sends "count" responses (3 when unset) with an increasing
+// "sequence". Response headers listed in the x-echo-set-header request
+// metadata, as "name:value" pairs separated by commas, are set on the
+// response.
+const (
+	grpcEchoServiceName = "gateway_api_conformance.echo_basic.grpcecho.GrpcEcho"
+
+	grpcEchoSetHeader = "x-echo-set-header"
+
+	defaultStreamCount = 3
+	maxStreamCount     = 100
+)
+
+var grpcEchoServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcEchoServiceName,
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Echo", Handler: grpcEchoUnaryHandler},
+		{MethodName: "EchoTwo", Handler: grpcEchoUnaryHandler},
+		{MethodName: "EchoThree", Handler: grpcEchoUnaryHandler},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "EchoStream", Handler: grpcEchoStreamHandler, ServerStreams: true},
+	},
+}
+
+func runGRPCServer(grpcPort string, errchan chan<- error) {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", grpcPort))
+	if err != nil {
+		errchan <- err
+		return
+	}
+	fmt.Printf("Starting server, listening on port %s (grpc)\n", grpcPort)
+	if err := newGRPCServer().Serve(lis); err != nil {
+		errchan <- err
+	}
+}
+
+func newGRPCServer() *grpc.Server {
+	server := grpc.NewServer()
+	server.RegisterService(&grpcEchoServiceDesc, nil)
+	return server
+}
+
+func grpcEchoUnaryHandler(_ interface{}, ctx stdcontext.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := &structpb.Struct{}
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	method, _ := grpc.Method(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+	fmt.Printf("Echoing back gRPC call to %s to client\n", method)
+
+	if err := grpc.SetHeader(ctx, grpcEchoResponseHeaders(md)); err != nil {
+		return nil, err
+	}
+	return grpcEchoAssertions(method, md, -1)
+}
+
+func grpcEchoStreamHandler(_ interface{}, stream grpc.ServerStream) error {
+	in := &structpb.Struct{}
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+	method, _ := grpc.Method(stream.Context())
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	fmt.Printf("Echoing back gRPC stream to %s to client\n", method)
+
+	count := defaultStreamCount
+	if v, ok := in.GetFields()["count"]; ok {
+		count = int(v.GetNumberValue())
+	}
+	if count < 0 || count > maxStreamCount {
+		return status.Errorf(codes.InvalidArgument, "count must be between 0 and %d", maxStreamCount)
+	}
+
+	if err := stream.SetHeader(grpcEchoResponseHeaders(md)); err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		out, err := grpcEchoAssertions(method, md, i)
+		if err != nil {
+			return err
+		}
+		if err := stream.SendMsg(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// grpcEchoAssertions builds the response describing a call. The sequence is
+// only set when it is not negative.
+func grpcEchoAssertions(method string, md metadata.MD, sequence int) (*structpb.Struct, error) {
+	headers := map[string]interface{}{}
+	for name, values := range md {
+		if strings.HasPrefix(name, ":") {
+			continue
+		}
+		list := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			list = append(list, v)
+		}
+		headers[name] = list
+	}
+
+	var authority string
+	if values := md.Get(":authority"); len(values) > 0 {
+		authority = values[0]
+	}
+
+	fields := map[string]interface{}{
+		"method":    method,
+		"authority": authority,
+		"headers":   headers,
+		"namespace": context.Namespace,
+		"ingress":   context.Ingress,
+		"service":   context.Service,
+		"pod":       context.Pod,
+	}
+	if sequence >= 0 {
+		fields["sequence"] = sequence
+	}
+	return structpb.NewStruct(fields)
+}
+
+// grpcEchoResponseHeaders returns the response headers requested with the
+// x-echo-set-header metadata.
+func grpcEchoResponseHeaders(md metadata.MD) metadata.MD {
+	out := metadata.MD{}
+	for _, headerKVList := range md.Get(grpcEchoSetHeader) {
+		for _, headerKV := range strings.Split(headerKVList, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(headerKV), ":")
+			if name == "" {
+				continue
+			}
+			out.Append(name, strings.TrimSpace(value))
+		}
+	}
+	return out
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	stdcontext "context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func dialGRPCEchoServer(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := newGRPCServer()
+	go server.Serve(lis) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithAuthority("grpc.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestGRPCEchoUnary(t *testing.T) {
+	conn := dialGRPCEchoServer(t)
+	context = Context{Namespace: "gateway-conformance-infra", Pod: "grpc-infra-backend-v1-abc"}
+	defer func() { context = Context{} }()
+
+	for _, method := range []string{"Echo", "EchoTwo", "EchoThree"} {
+		ctx, cancel := stdcontext.WithTimeout(stdcontext.Background(), 5*time.Second)
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-test", "one", grpcEchoSetHeader, "x-echoed: yes, x-other:two")
+
+		fullMethod := "/" + grpcEchoServiceName + "/" + method
+		var header metadata.MD
+		out := &structpb.Struct{}
+		err := conn.Invoke(ctx, fullMethod, &structpb.Struct{}, out, grpc.Header(&header))
+		cancel()
+		if err != nil {
+			t.Fatalf("Expected no error calling %s, but got %v", method, err)
+		}
+
+		fields := out.AsMap()
+		if fields["method"] != fullMethod {
+			t.Errorf("Expected method %s, but got %v", fullMethod, fields["method"])
+		}
+		if fields["authority"] != "grpc.example.com" {
+			t.Errorf("Expected authority grpc.example.com, but got %v", fields["authority"])
+		}
+		if fields["namespace"] != "gateway-conformance-infra" || fields["pod"] != "grpc-infra-backend-v1-abc" {
+			t.Errorf("Expected the context of the server, but got namespace %v and pod %v", fields["namespace"], fields["pod"])
+		}
+		if _, ok := fields["sequence"]; ok {
+			t.Errorf("Expected no sequence for unary calls, but got %v", fields["sequence"])
+		}
+		headers, _ := fields["headers"].(map[string]interface{})
+		if values, _ := headers["x-test"].([]interface{}); len(values) != 1 || values[0] != "one" {
+			t.Errorf("Expected x-test header to be echoed, but got %v", headers["x-test"])
+		}
+		if _, ok := headers[":authority"]; ok {
+			t.Errorf("Expected pseudo headers not to be echoed as headers")
+		}
+		if got := header.Get("x-echoed"); len(got) != 1 || got[0] != "yes" {
+			t.Errorf("Expected x-echoed response header to be yes, but got %v", got)
+		}
+		if got := header.Get("x-other"); len(got) != 1 || got[0] != "two" {
+			t.Errorf("Expected x-other response header to be two, but got %v", got)
+		}
+	}
+}
+
+func TestGRPCEchoStream(t *testing.T) {
+	conn := dialGRPCEchoServer(t)
+	streamDesc := &grpc.StreamDesc{StreamName: "EchoStream", ServerStreams: true}
+	fullMethod := "/" + grpcEchoServiceName + "/EchoStream"
+
+	recvAll := func(count *float64) ([]*structpb.Struct, error) {
+		ctx, cancel := stdcontext.WithTimeout(stdcontext.Background(), 5*time.Second)
+		defer cancel()
+		stream, err := conn.NewStream(ctx, streamDesc, fullMethod)
+		if err != nil {
+			return nil, err
+		}
+		in := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		if count != nil {
+			in.Fields["count"] = structpb.NewNumberValue(*count)
+		}
+		if err := stream.SendMsg(in); err != nil {
+			return nil, err
+		}
+		if err := stream.CloseSend(); err != nil {
+			return nil, err
+		}
+		var msgs []*structpb.Struct
+		for {
+			out := &structpb.Struct{}
+			err := stream.RecvMsg(out)
+			if errors.Is(err, io.EOF) {
+				return msgs, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, out)
+		}
+	}
+
+	msgs, err := recvAll(nil)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(msgs) != defaultStreamCount {
+		t.Fatalf("Expected %d messages, but got %d", defaultStreamCount, len(msgs))
+	}
+	for i, msg := range msgs {
+		fields := msg.AsMap()
+		if fields["sequence"] != float64(i) {
+			t.Errorf("Expected sequence %d, but got %v", i, fields["sequence"])
+		}
+		if fields["method"] != fullMethod {
+			t.Errorf("Expected method %s, but got %v", fullMethod, fields["method"])
+		}
+	}
+
+	five := float64(5)
+	msgs, err = recvAll(&five)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(msgs) != 5 {
+		t.Errorf("Expected 5 messages, but got %d", len(msgs))
+	}
+
+	tooMany := float64(maxStreamCount + 1)
+	_, err = recvAll(&tooMany)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, but got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// The TCP and UDP echo listeners respond to each line received on a TCP
+// connection, and to each UDP datagram, with a single JSON encoded
+// L4Assertions followed by a newline.
+const maxL4PayloadBytes = 64 * 1024
+
+// L4Assertions contains information about a TCP or UDP payload and the pod
+// it reached.
+type L4Assertions struct {
+	Protocol string `json:"protocol"`
+	Payload  string `json:"payload"`
+
+	Context `json:",inline"`
+}
+
+func runTCPServer(tcpPort string, errchan chan<- error) {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", tcpPort))
+	if err != nil {
+		errchan <- err
+		return
+	}
+	fmt.Printf("Starting server, listening on port %s (tcp)\n", tcpPort)
+	if err := serveTCP(lis); err != nil {
+		errchan <- err
+	}
+}
+
+func serveTCP(lis net.Listener) error {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go handleTCPConn(conn)
+	}
+}
+
+func handleTCPConn(conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 4096), maxL4PayloadBytes)
+	for scanner.Scan() {
+		js, err := l4Assertions("tcp", strings.TrimSuffix(scanner.Text(), "\r"))
+		if err != nil {
+			fmt.Printf("Failed to encode TCP response: %v\n", err)
+			return
+		}
+		if _, err := conn.Write(js); err != nil {
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Printf("Failed to read from TCP connection from %s: %v\n", conn.RemoteAddr(), err)
+	}
+}
+
+func runUDPServer(udpPort string, errchan chan<- error) {
+	pc, err := net.ListenPacket("udp", fmt.Sprintf(":%s", udpPort))
+	if err != nil {
+		errchan <- err
+		return
+	}
+	fmt.Printf("Starting server, listening on port %s (udp)\n", udpPort)
+	if err := serveUDP(pc); err != nil {
+		errchan <- err
+	}
+}
+
+func serveUDP(pc net.PacketConn) error {
+	buf := make([]byte, maxL4PayloadBytes)
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		js, err := l4Assertions("udp", strings.TrimRight(string(buf[:n]), "\r\n"))
+		if err != nil {
+			fmt.Printf("Failed to encode UDP response: %v\n", err)
+			continue
+		}
+		if _, err := pc.WriteTo(js, addr); err != nil {
+			fmt.Printf("Failed to write UDP response to %s: %v\n", addr, err)
+		}
+	}
+}
+
+func l4Assertions(protocol, payload string) ([]byte, error) {
+	js, err := json.Marshal(L4Assertions{
+		Protocol: protocol,
+		Payload:  payload,
+		Context:  context,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(js, '\n'), nil
+}
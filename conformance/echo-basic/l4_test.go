@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestTCPEcho(t *testing.T) {
+	context = Context{Namespace: "gateway-conformance-infra", Pod: "infra-backend-v1-abc"}
+	defer func() { context = Context{} }()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	go serveTCP(lis) //nolint:errcheck
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	reader := bufio.NewReader(conn)
+	for _, payload := range []string{"hello", "second line"} {
+		if _, err := conn.Write([]byte(payload + "\n")); err != nil {
+			t.Fatal(err)
+		}
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		var assertions L4Assertions
+		if err := json.Unmarshal(line, &assertions); err != nil {
+			t.Fatalf("Expected a JSON response, but got %q: %v", line, err)
+		}
+		expected := L4Assertions{Protocol: "tcp", Payload: payload, Context: context}
+		if assertions != expected {
+			t.Errorf("Expected %+v, but got %+v", expected, assertions)
+		}
+	}
+}
+
+func TestUDPEcho(t *testing.T) {
+	context = Context{Namespace: "gateway-conformance-infra", Pod: "infra-backend-v2-abc"}
+	defer func() { context = Context{} }()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+	go serveUDP(pc) //nolint:errcheck
+
+	conn, err := net.Dial("udp", pc.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.Write([]byte("datagram\n")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, maxL4PayloadBytes)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var assertions L4Assertions
+	if err := json.Unmarshal(buf[:n], &assertions); err != nil {
+		t.Fatalf("Expected a JSON response, but got %q: %v", buf[:n], err)
+	}
+	expected := L4Assertions{Protocol: "udp", Payload: "datagram", Context: context}
+	if assertions != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, assertions)
+	}
+}
@@ -30,7 +30,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
-	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+	confv1a2 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/conformance/tests"
 	"sigs.k8s.io/gateway-api/conformance/utils/flags"
 	"sigs.k8s.io/gateway-api/conformance/utils/suite"
@@ -44,7 +44,7 @@ var (
 	exemptFeatures       sets.Set[suite.SupportedFeature]
 	namespaceLabels      map[string]string
 	namespaceAnnotations map[string]string
-	implementation       *confv1a1.Implementation
+	implementation       *confv1a2.Implementation
 	conformanceProfiles  sets.Set[suite.ConformanceProfileName]
 	skipTests            []string
 )
@@ -101,6 +101,14 @@ func testExperimentalConformance(t *testing.T) {
 	t.Logf("Running experimental conformance tests with %s GatewayClass\n cleanup: %t\n debug: %t\n enable all features: %t \n supported features: [%v]\n exempt features: [%v]",
 		*flags.GatewayClassName, *flags.CleanupBaseResources, *flags.ShowDebug, *flags.EnableAllSupportedFeatures, *flags.SupportedFeatures, *flags.ExemptFeatures)
 
+	var testReporters []suite.TestReporter
+	if *flags.JUnitOutput != "" {
+		testReporters = append(testReporters, suite.NewJUnitReporter(createOutput(t, *flags.JUnitOutput)))
+	}
+	if *flags.JSONEventsOutput != "" {
+		testReporters = append(testReporters, suite.NewJSONEventReporter(createOutput(t, *flags.JSONEventsOutput)))
+	}
+
 	cSuite, err := suite.NewExperimentalConformanceTestSuite(
 		suite.ExperimentalConformanceOptions{
 			Options: suite.Options{
@@ -108,26 +116,32 @@ func testExperimentalConformance(t *testing.T) {
 				RestConfig: cfg,
 				// This clientset is needed in addition to the client only because
 				// controller-runtime client doesn't support non CRUD sub-resources yet (https://github.com/kubernetes-sigs/controller-runtime/issues/452).
-				Clientset:                  k8sClientset,
-				GatewayClassName:           *flags.GatewayClassName,
-				Debug:                      *flags.ShowDebug,
-				CleanupBaseResources:       *flags.CleanupBaseResources,
-				SupportedFeatures:          supportedFeatures,
-				ExemptFeatures:             exemptFeatures,
-				EnableAllSupportedFeatures: *flags.EnableAllSupportedFeatures,
-				NamespaceLabels:            namespaceLabels,
-				NamespaceAnnotations:       namespaceAnnotations,
-				SkipTests:                  skipTests,
+				Clientset:                         k8sClientset,
+				GatewayClassName:                  *flags.GatewayClassName,
+				Debug:                             *flags.ShowDebug,
+				CleanupBaseResources:              *flags.CleanupBaseResources,
+				SupportedFeatures:                 supportedFeatures,
+				ExemptFeatures:                    exemptFeatures,
+				EnableAllSupportedFeatures:        *flags.EnableAllSupportedFeatures,
+				SupportedFeaturesFromGatewayClass: *flags.SupportedFeatures == suite.SupportedFeaturesFromGatewayClass,
+				NamespaceLabels:                   namespaceLabels,
+				NamespaceAnnotations:              namespaceAnnotations,
+				SkipTests:                         skipTests,
+				Parallelism:                       *flags.Parallelism,
+				DiagnosticsDir:                    *flags.DiagnosticsDir,
 			},
 			Implementation:      *implementation,
 			ConformanceProfiles: conformanceProfiles,
+			TestReporters:       testReporters,
 		})
 	if err != nil {
 		t.Fatalf("error creating experimental conformance test suite: %v", err)
 	}
 
 	cSuite.Setup(t)
-	cSuite.Run(t, tests.ConformanceTests)
+	if err := cSuite.Run(t, tests.ConformanceTests); err != nil {
+		t.Fatalf("error running conformance tests: %v", err)
+	}
 	report, err := cSuite.Report()
 	if err != nil {
 		t.Fatalf("error generating conformance profile report: %v", err)
@@ -135,7 +149,22 @@ func testExperimentalConformance(t *testing.T) {
 	writeReport(t.Logf, *report, *flags.ReportOutput)
 }
 
-func writeReport(logf func(string, ...any), report confv1a1.ConformanceReport, output string) error {
+// createOutput creates the file where test reports are written, which is
+// closed once the test completes.
+func createOutput(t *testing.T, output string) *os.File {
+	f, err := os.Create(output)
+	if err != nil {
+		t.Fatalf("error creating %s: %v", output, err)
+	}
+	t.Cleanup(func() {
+		if err := f.Close(); err != nil {
+			t.Errorf("error closing %s: %v", output, err)
+		}
+	})
+	return f
+}
+
+func writeReport(logf func(string, ...any), report confv1a2.ConformanceReport, output string) error {
 	rawReport, err := yaml.Marshal(report)
 	if err != nil {
 		return err
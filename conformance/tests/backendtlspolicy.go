@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, BackendTLSPolicy)
+}
+
+var BackendTLSPolicy = suite.ConformanceTest{
+	ShortName:   "BackendTLSPolicy",
+	Description: "A BackendTLSPolicy makes the Gateway originate TLS to a Service backend, with the configured SNI and validated against the CA certificate of a ConfigMap",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportHTTPRoute,
+		suite.SupportBackendTLSPolicy,
+	},
+	Manifests: []string{"tests/backendtlspolicy.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "backendtlspolicy", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+
+		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
+
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		t.Run("HTTP request should reach the backend over TLS with the SNI of the policy", func(t *testing.T) {
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
+				Request: http.Request{Path: "/backendtls"},
+				ExpectedRequest: &http.ExpectedRequest{
+					Request: http.Request{Path: "/backendtls"},
+					SNI:     "abc.example.com",
+				},
+				Backend:   "backend-tls-checks",
+				Namespace: ns,
+			})
+		})
+
+		// The status code returned when the certificate of the backend can
+		// not be validated is not specified, only that the request fails.
+		t.Run("HTTP request should fail when the CA of the policy did not sign the certificate of the backend", func(t *testing.T) {
+			expected := http.ExpectedResponse{
+				Request: http.Request{Path: "/backendtls-mismatched-ca"},
+			}
+			req := http.MakeRequest(t, &expected, gwAddr, "HTTP", "http")
+
+			http.AwaitConvergence(t, suite.TimeoutConfig.RequiredConsecutiveSuccesses, suite.TimeoutConfig.MaxTimeToConsistency, func(elapsed time.Duration) bool {
+				cReq, cRes, err := suite.RoundTripper.CaptureRoundTrip(req)
+				if err != nil {
+					t.Logf("Request failed, not ready yet: %v (after %v)", err.Error(), elapsed)
+					return false
+				}
+				if cRes.StatusCode < 500 {
+					t.Logf("Expected a 5xx status code, got %d from pod %s (after %v)", cRes.StatusCode, cReq.Pod, elapsed)
+					return false
+				}
+				return true
+			})
+		})
+	},
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GRPCRouteRequestHeaderModifier)
+}
+
+var GRPCRouteRequestHeaderModifier = suite.ConformanceTest{
+	ShortName:   "GRPCRouteRequestHeaderModifier",
+	Description: "A GRPCRoute with request header modifier filters",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportGRPCRoute,
+	},
+	Manifests: []string{"tests/grpcroute-request-header-modifier.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "grpc-request-header-modifier", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndGRPCRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		testCases := []grpc.ExpectedResponse{{
+			Request: grpc.Request{
+				Method: "Echo",
+				Headers: map[string]string{
+					"Some-Other-Header": "val",
+				},
+			},
+			ExpectedRequest: &grpc.ExpectedRequest{
+				Request: grpc.Request{
+					Headers: map[string]string{
+						"Some-Other-Header": "val",
+						"X-Header-Set":      "set-overwrites-values",
+					},
+				},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}, {
+			Request: grpc.Request{
+				Method: "Echo",
+				Headers: map[string]string{
+					"Some-Other-Header": "val",
+					"X-Header-Set":      "some-other-value",
+				},
+			},
+			ExpectedRequest: &grpc.ExpectedRequest{
+				Request: grpc.Request{
+					Headers: map[string]string{
+						"Some-Other-Header": "val",
+						"X-Header-Set":      "set-overwrites-values",
+					},
+				},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}, {
+			Request: grpc.Request{
+				Method: "EchoTwo",
+				Headers: map[string]string{
+					"Some-Other-Header": "val",
+				},
+			},
+			ExpectedRequest: &grpc.ExpectedRequest{
+				Request: grpc.Request{
+					Headers: map[string]string{
+						"Some-Other-Header": "val",
+						"X-Header-Add":      "add-appends-values",
+					},
+				},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}, {
+			Request: grpc.Request{
+				Method: "EchoTwo",
+				Headers: map[string]string{
+					"Some-Other-Header": "val",
+					"X-Header-Add":      "some-other-value",
+				},
+			},
+			ExpectedRequest: &grpc.ExpectedRequest{
+				Request: grpc.Request{
+					Headers: map[string]string{
+						"Some-Other-Header": "val",
+						"X-Header-Add":      "some-other-value,add-appends-values",
+					},
+				},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}, {
+			Request: grpc.Request{
+				Method: "EchoThree",
+				Headers: map[string]string{
+					"Some-Other-Header": "val",
+					"X-Header-Remove":   "val",
+				},
+			},
+			ExpectedRequest: &grpc.ExpectedRequest{
+				Request: grpc.Request{
+					Headers: map[string]string{
+						"Some-Other-Header": "val",
+					},
+				},
+				AbsentHeaders: []string{"X-Header-Remove"},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				grpc.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.GRPCRoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
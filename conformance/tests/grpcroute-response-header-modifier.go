@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GRPCRouteResponseHeaderModifier)
+}
+
+var GRPCRouteResponseHeaderModifier = suite.ConformanceTest{
+	ShortName:   "GRPCRouteResponseHeaderModifier",
+	Description: "A GRPCRoute with response header modifier filters",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportGRPCRoute,
+		suite.SupportGRPCRouteResponseHeaderModification,
+	},
+	Manifests: []string{"tests/grpcroute-response-header-modifier.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "grpc-response-header-modifier", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndGRPCRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		testCases := []grpc.ExpectedResponse{{
+			Request: grpc.Request{Method: "Echo"},
+			Response: grpc.Response{
+				Headers: map[string]string{
+					"X-Header-Set": "header-set",
+					"X-Header-Add": "header-add",
+				},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}, {
+			Request: grpc.Request{Method: "Echo"},
+			BackendSetResponseHeaders: map[string]string{
+				"X-Header-Set":    "some-other-value",
+				"X-Header-Add":    "add-appends-values",
+				"X-Header-Remove": "val",
+			},
+			Response: grpc.Response{
+				Headers: map[string]string{
+					"X-Header-Set": "header-set",
+					"X-Header-Add": "add-appends-values,header-add",
+				},
+				AbsentHeaders: []string{"X-Header-Remove"},
+			},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				grpc.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.GRPCRoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GRPCRouteWeight)
+}
+
+var GRPCRouteWeight = suite.ConformanceTest{
+	ShortName:   "GRPCRouteWeight",
+	Description: "A GRPCRoute with weighted backends",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportGRPCRoute,
+	},
+	Manifests: []string{"tests/grpcroute-weight.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "grpc-weight", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndGRPCRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		const (
+			totalCalls = 100
+			// tolerance is the allowed deviation from the expected share of
+			// calls of each backend, as a fraction of the total calls.
+			tolerance = 0.1
+		)
+		expectedWeights := map[string]float64{
+			"infra-backend-v1": 0.7,
+			"infra-backend-v2": 0.3,
+			"infra-backend-v3": 0,
+		}
+
+		expected := grpc.ExpectedResponse{
+			Request:   grpc.Request{Method: "Echo"},
+			Namespace: ns,
+		}
+		req := grpc.MakeRequest(t, &expected, gwAddr)
+
+		// Wait for the route to be programmed before measuring the
+		// distribution of calls.
+		grpc.WaitForConsistentResponse(t, suite.GRPCRoundTripper, req, expected, suite.TimeoutConfig.RequiredConsecutiveSuccesses, suite.TimeoutConfig.MaxTimeToConsistency)
+
+		http.AwaitConvergence(t, 1, suite.TimeoutConfig.MaxTimeToConsistency, func(_ time.Duration) bool {
+			if err := testGRPCWeightDistribution(suite, req, totalCalls, tolerance, expectedWeights); err != nil {
+				t.Logf("Traffic distribution test failed (%v)", err)
+				return false
+			}
+			return true
+		})
+	},
+}
+
+func testGRPCWeightDistribution(suite *suite.ConformanceTestSuite, req roundtripper.GRPCRequest, totalCalls int, tolerance float64, expectedWeights map[string]float64) error {
+	seen := map[string]int{}
+	for i := 0; i < totalCalls; i++ {
+		cReq, cRes, err := suite.GRPCRoundTripper.CaptureGRPCRoundTrip(req)
+		if err != nil {
+			return fmt.Errorf("gRPC call failed: %w", err)
+		}
+		if cRes.Code != codes.OK {
+			return fmt.Errorf("expected status code OK, got %s", cRes.Code)
+		}
+		backend := "unknown"
+		for name := range expectedWeights {
+			if strings.HasPrefix(cReq.Pod, name) {
+				backend = name
+			}
+		}
+		seen[backend]++
+	}
+
+	for backend, count := range seen {
+		weight, ok := expectedWeights[backend]
+		if !ok {
+			return fmt.Errorf("calls were routed to an unexpected backend %q", backend)
+		}
+		if weight == 0 {
+			return fmt.Errorf("%d calls were routed to backend %q with weight 0", count, backend)
+		}
+	}
+	for backend, weight := range expectedWeights {
+		actual := float64(seen[backend]) / float64(totalCalls)
+		if math.Abs(actual-weight) > tolerance {
+			return fmt.Errorf("backend %q received %.2f of the calls, expected %.2f±%.2f", backend, actual, weight, tolerance)
+		}
+	}
+	return nil
+}
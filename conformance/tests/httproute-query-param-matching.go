@@ -34,13 +34,14 @@ var HTTPRouteQueryParamMatching = suite.ConformanceTest{
 	ShortName:   "HTTPRouteQueryParamMatching",
 	Description: "A single HTTPRoute with query param matching for different backends",
 	Manifests:   []string{"tests/httproute-query-param-matching.yaml"},
+	Parallel:    true,
 	Features: []suite.SupportedFeature{
 		suite.SupportGateway,
 		suite.SupportHTTPRoute,
 		suite.SupportHTTPRouteQueryParamMatching,
 	},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		ns := "gateway-conformance-infra"
+		ns := suite.Namespace("gateway-conformance-infra")
 		routeNN := types.NamespacedName{Namespace: ns, Name: "query-param-matching"}
 		gwNN := types.NamespacedName{Namespace: ns, Name: "same-namespace"}
 		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteListenerPort)
+}
+
+var TCPRouteListenerPort = suite.ConformanceTest{
+	ShortName:   "TCPRouteListenerPort",
+	Description: "TCPRoutes attached to different listeners of a Gateway only receive connections made to the port of their listener",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportTCPRoute,
+	},
+	Manifests: []string{"tests/tcproute-listener-port.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeANN := types.NamespacedName{Name: "listener-port-a", Namespace: ns}
+		routeBNN := types.NamespacedName{Name: "listener-port-b", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "gateway-tcproute-listener-port", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN, "tcp-a"), routeANN)
+		kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN, "tcp-b"), routeBNN)
+
+		testCases := []l4.ExpectedResponse{{
+			Request:   l4.Request{Protocol: "TCP", Port: 9000},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}, {
+			Request:   l4.Request{Protocol: "TCP", Port: 9001},
+			Backend:   "infra-backend-v2",
+			Namespace: ns,
+		}, {
+			Request:     l4.Request{Protocol: "TCP", Port: 9002},
+			Unreachable: true,
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				l4.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.L4RoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteReferenceGrant)
+}
+
+var TCPRouteReferenceGrant = suite.ConformanceTest{
+	ShortName:   "TCPRouteReferenceGrant",
+	Description: "A single TCPRoute in the gateway-conformance-infra namespace, with a backendRef in the gateway-conformance-app-backend namespace, should attach to Gateway in the gateway-conformance-infra namespace",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportTCPRoute,
+		suite.SupportReferenceGrant,
+	},
+	Manifests: []string{"tests/tcproute-reference-grant.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		routeNN := types.NamespacedName{Name: "reference-grant", Namespace: "gateway-conformance-infra"}
+		gwNN := types.NamespacedName{Name: "gateway-tcproute-referencegrant", Namespace: "gateway-conformance-infra"}
+		gwAddr := kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		t.Run("Simple TCP payload should reach app-backend", func(t *testing.T) {
+			l4.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.L4RoundTripper, suite.TimeoutConfig, gwAddr, l4.ExpectedResponse{
+				Request:   l4.Request{Protocol: "TCP"},
+				Backend:   "app-backend-v1",
+				Namespace: "gateway-conformance-app-backend",
+			})
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.DeleteTimeout)
+		defer cancel()
+		rg := v1beta1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "reference-grant",
+				Namespace: "gateway-conformance-app-backend",
+			},
+		}
+		require.NoError(t, suite.Client.Delete(ctx, &rg))
+
+		t.Run("Simple TCP payload should not reach app-backend after deleting the relevant reference grant", func(t *testing.T) {
+			l4.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.L4RoundTripper, suite.TimeoutConfig, gwAddr, l4.ExpectedResponse{
+				Request:     l4.Request{Protocol: "TCP"},
+				Unreachable: true,
+			})
+		})
+	},
+}
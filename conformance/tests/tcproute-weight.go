@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteWeight)
+}
+
+var TCPRouteWeight = suite.ConformanceTest{
+	ShortName:   "TCPRouteWeight",
+	Description: "A TCPRoute with weighted backends",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportTCPRoute,
+	},
+	Manifests: []string{"tests/tcproute-weight.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "tcp-weight", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "gateway-tcproute-weight", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		testL4Weights(t, suite, gwAddr, "TCP", ns)
+	},
+}
+
+// testL4Weights checks that connections to a Gateway are split between
+// infra-backend-v1 and infra-backend-v2 with weights of 70 and 30.
+func testL4Weights(t *testing.T, suite *suite.ConformanceTestSuite, gwAddr, protocol, ns string) {
+	t.Helper()
+
+	const (
+		totalRequests = 100
+		// tolerance is the allowed deviation from the expected share of
+		// requests of each backend, as a fraction of the total requests.
+		tolerance = 0.1
+	)
+	expectedWeights := map[string]float64{
+		"infra-backend-v1": 0.7,
+		"infra-backend-v2": 0.3,
+	}
+
+	expected := l4.ExpectedResponse{
+		Request:   l4.Request{Protocol: protocol},
+		Namespace: ns,
+	}
+	req := l4.MakeRequest(t, &expected, gwAddr)
+
+	// Wait for the route to be programmed before measuring the distribution
+	// of requests.
+	l4.WaitForConsistentResponse(t, suite.L4RoundTripper, req, expected, suite.TimeoutConfig.RequiredConsecutiveSuccesses, suite.TimeoutConfig.MaxTimeToConsistency)
+
+	http.AwaitConvergence(t, 1, suite.TimeoutConfig.MaxTimeToConsistency, func(_ time.Duration) bool {
+		if err := testL4WeightDistribution(suite.L4RoundTripper, req, totalRequests, tolerance, expectedWeights); err != nil {
+			t.Logf("Traffic distribution test failed (%v)", err)
+			return false
+		}
+		return true
+	})
+}
+
+func testL4WeightDistribution(r roundtripper.L4RoundTripper, req roundtripper.L4Request, totalRequests int, tolerance float64, expectedWeights map[string]float64) error {
+	seen := map[string]int{}
+	for i := 0; i < totalRequests; i++ {
+		cRes, err := r.CaptureL4RoundTrip(req)
+		if err != nil {
+			return fmt.Errorf("%s request failed: %w", req.Protocol, err)
+		}
+		backend := "unknown"
+		for name := range expectedWeights {
+			if strings.HasPrefix(cRes.Pod, name) {
+				backend = name
+			}
+		}
+		if _, ok := expectedWeights[backend]; !ok {
+			return fmt.Errorf("%s request was routed to an unexpected pod %q", req.Protocol, cRes.Pod)
+		}
+		seen[backend]++
+	}
+
+	for backend, weight := range expectedWeights {
+		actual := float64(seen[backend]) / float64(totalRequests)
+		if math.Abs(actual-weight) > tolerance {
+			return fmt.Errorf("backend %q received %.2f of the requests, expected %.2f±%.2f", backend, actual, weight, tolerance)
+		}
+	}
+	return nil
+}
@@ -29,4 +29,6 @@ var (
 	ImplementationContact      = flag.String("contact", "", "Comma-separated list of contact information for the maintainers")
 	ConformanceProfiles        = flag.String("conformance-profiles", "", "Comma-separated list of the conformance profiles to run")
 	ReportOutput               = flag.String("report-output", "", "The file where to write the conformance report")
+	JUnitOutput                = flag.String("junit-output", "", "The file where to write the JUnit XML report of the tests")
+	JSONEventsOutput           = flag.String("json-events-output", "", "The file where to stream JSON events for the start and end of each test")
 )
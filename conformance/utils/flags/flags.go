@@ -27,11 +27,13 @@ var (
 	GatewayClassName           = flag.String("gateway-class", "gateway-conformance", "Name of GatewayClass to use for tests")
 	ShowDebug                  = flag.Bool("debug", false, "Whether to print debug logs")
 	CleanupBaseResources       = flag.Bool("cleanup-base-resources", true, "Whether to cleanup base test resources after the run")
-	SupportedFeatures          = flag.String("supported-features", "", "Supported features included in conformance tests suites")
+	SupportedFeatures          = flag.String("supported-features", "", "Supported features included in conformance tests suites, or from-gatewayclass to read them from the status of the GatewayClass")
 	SkipTests                  = flag.String("skip-tests", "", "Comma-separated list of tests to skip")
 	RunTest                    = flag.String("run-test", "", "Name of a single test to run, instead of the whole suite")
 	ExemptFeatures             = flag.String("exempt-features", "", "Exempt Features excluded from conformance tests suites")
 	EnableAllSupportedFeatures = flag.Bool("all-features", false, "Whether to enable all supported features for conformance tests")
 	NamespaceLabels            = flag.String("namespace-labels", "", "Comma-separated list of name=value labels to add to test namespaces")
 	NamespaceAnnotations       = flag.String("namespace-annotations", "", "Comma-separated list of name=value annotations to add to test namespaces")
+	DiagnosticsDir             = flag.String("diagnostics-dir", "", "Directory the diagnostics bundles of failed tests are written to, defaults to a directory in the system temporary directory")
+	Parallelism                = flag.Int("parallelism", 1, "Maximum number of tests run in parallel, only tests supporting it run in isolated namespaces")
 )
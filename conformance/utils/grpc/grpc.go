@@ -0,0 +1,260 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+// ExpectedResponse defines the response expected for a given gRPC call.
+type ExpectedResponse struct {
+	// Request defines the call to make.
+	Request Request
+
+	// ExpectedRequest defines the call that is expected to arrive at the
+	// backend. If not specified, the backend call will be expected to match
+	// Request.
+	ExpectedRequest *ExpectedRequest
+
+	// BackendSetResponseHeaders is a set of headers the echo service should
+	// set in its response.
+	BackendSetResponseHeaders map[string]string
+
+	// Response defines what response the test case should receive.
+	Response Response
+
+	Backend   string
+	Namespace string
+
+	// User Given TestCase name
+	TestCaseName string
+}
+
+// Request can be used as both the call to make and a means to verify that
+// the echo service received the expected call. Note that multiple header
+// values can be provided, as a comma-separated value.
+type Request struct {
+	// Host is the authority of the call, the Gateway address is used when
+	// it is empty.
+	Host string
+	// Service is the fully qualified name of the service, it defaults to
+	// the gRPC echo service.
+	Service string
+	// Method is the name of the method to call, it defaults to Echo.
+	Method  string
+	Headers map[string]string
+	// StreamCount is the number of messages to request from a server
+	// streaming method. The call is unary when it is not set.
+	StreamCount int
+}
+
+// ExpectedRequest defines expected properties of a call that reaches a
+// backend.
+type ExpectedRequest struct {
+	Request
+
+	// AbsentHeaders are names of headers that are expected *not* to be
+	// present on the call.
+	AbsentHeaders []string
+}
+
+// Response defines expected properties of a response from a backend.
+type Response struct {
+	Code          codes.Code
+	Headers       map[string]string
+	AbsentHeaders []string
+}
+
+// MakeRequestAndExpectEventuallyConsistentResponse makes a gRPC call with the
+// given parameters, understanding that the call may fail for some amount of
+// time.
+//
+// Once the call succeeds consistently with the response having the expected
+// status code, make additional assertions on the response using the provided
+// ExpectedResponse.
+func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, r roundtripper.GRPCRoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse) {
+	t.Helper()
+
+	req := MakeRequest(t, &expected, gwAddr)
+
+	WaitForConsistentResponse(t, r, req, expected, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig.MaxTimeToConsistency)
+}
+
+// MakeRequest builds the gRPC call described by the expected response,
+// setting defaults on it.
+func MakeRequest(t *testing.T, expected *ExpectedResponse, gwAddr string) roundtripper.GRPCRequest {
+	t.Helper()
+
+	if expected.Request.Method == "" {
+		expected.Request.Method = "Echo"
+	}
+	if expected.Request.Service == "" {
+		expected.Request.Service = roundtripper.GRPCEchoServiceName
+	}
+
+	t.Logf("Making gRPC call to %s/%s on %s", expected.Request.Service, expected.Request.Method, gwAddr)
+
+	req := roundtripper.GRPCRequest{
+		Address:     gwAddr,
+		Authority:   expected.Request.Host,
+		Service:     expected.Request.Service,
+		Method:      expected.Request.Method,
+		Metadata:    map[string][]string{},
+		StreamCount: expected.Request.StreamCount,
+	}
+
+	for name, value := range expected.Request.Headers {
+		req.Metadata[strings.ToLower(name)] = []string{value}
+	}
+
+	if len(expected.BackendSetResponseHeaders) > 0 {
+		backendSetHeaders := []string{}
+		for name, val := range expected.BackendSetResponseHeaders {
+			backendSetHeaders = append(backendSetHeaders, name+":"+val)
+		}
+		req.Metadata["x-echo-set-header"] = []string{strings.Join(backendSetHeaders, ",")}
+	}
+
+	return req
+}
+
+// WaitForConsistentResponse repeats the provided call until it completes
+// with a response having the expected response consistently. The provided
+// threshold determines how many times in a row this must occur to be
+// considered "consistent".
+func WaitForConsistentResponse(t *testing.T, r roundtripper.GRPCRoundTripper, req roundtripper.GRPCRequest, expected ExpectedResponse, threshold int, maxTimeToConsistency time.Duration) {
+	http.AwaitConvergence(t, threshold, maxTimeToConsistency, func(elapsed time.Duration) bool {
+		cReq, cRes, err := r.CaptureGRPCRoundTrip(req)
+		if err != nil {
+			t.Logf("gRPC call failed, not ready yet: %v (after %v)", err.Error(), elapsed)
+			return false
+		}
+
+		if err := CompareRequest(&req, cReq, cRes, expected); err != nil {
+			t.Logf("Response expectation failed for gRPC call: %+v  not ready yet: %v (after %v)", req, err, elapsed)
+			return false
+		}
+
+		return true
+	})
+	t.Logf("gRPC call passed")
+}
+
+// CompareRequest checks that the captured call and response match the
+// expected response.
+func CompareRequest(req *roundtripper.GRPCRequest, cReq *roundtripper.CapturedGRPCRequest, cRes *roundtripper.CapturedGRPCResponse, expected ExpectedResponse) error {
+	if expected.Response.Code != cRes.Code {
+		return fmt.Errorf("expected status code to be %s, got %s (%s)", expected.Response.Code, cRes.Code, cRes.Message)
+	}
+	if cRes.Code != codes.OK {
+		return nil
+	}
+	if cReq == nil {
+		return fmt.Errorf("no call captured by the backend")
+	}
+
+	// The call expected to arrive at the backend is the same as the call
+	// made, unless otherwise specified.
+	if expected.ExpectedRequest == nil {
+		expected.ExpectedRequest = &ExpectedRequest{Request: expected.Request}
+	}
+	if req.StreamCount > 0 && cRes.Messages != req.StreamCount {
+		return fmt.Errorf("expected %d messages, got %d", req.StreamCount, cRes.Messages)
+	}
+
+	service := expected.ExpectedRequest.Service
+	if service == "" {
+		service = req.Service
+	}
+	method := expected.ExpectedRequest.Method
+	if method == "" {
+		method = req.Method
+	}
+	fullMethod := roundtripper.GRPCRequest{Service: service, Method: method}.FullMethod()
+	if fullMethod != cReq.FullMethod {
+		return fmt.Errorf("expected method to be %s, got %s", fullMethod, cReq.FullMethod)
+	}
+	if expected.ExpectedRequest.Host != "" && expected.ExpectedRequest.Host != cReq.Authority {
+		return fmt.Errorf("expected authority to be %s, got %s", expected.ExpectedRequest.Host, cReq.Authority)
+	}
+	if expected.Namespace != cReq.Namespace {
+		return fmt.Errorf("expected namespace to be %s, got %s", expected.Namespace, cReq.Namespace)
+	}
+
+	if err := compareHeaders(expected.ExpectedRequest.Headers, expected.ExpectedRequest.AbsentHeaders, cReq.Headers); err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+	if err := compareHeaders(expected.Response.Headers, expected.Response.AbsentHeaders, cRes.Headers); err != nil {
+		return fmt.Errorf("response: %w", err)
+	}
+
+	if !strings.HasPrefix(cReq.Pod, expected.Backend) {
+		return fmt.Errorf("expected pod name to start with %s, got %s", expected.Backend, cReq.Pod)
+	}
+	return nil
+}
+
+// compareHeaders checks gRPC metadata, which always has lower case names,
+// against the expected and absent headers.
+func compareHeaders(expected map[string]string, absent []string, actual map[string][]string) error {
+	for name, expectedVal := range expected {
+		actualVal, ok := actual[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("expected %s header to be set, actual headers: %v", name, actual)
+		} else if strings.Join(actualVal, ",") != expectedVal {
+			return fmt.Errorf("expected %s header to be set to %s, got %s", name, expectedVal, strings.Join(actualVal, ","))
+		}
+	}
+	for _, name := range absent {
+		if val, ok := actual[strings.ToLower(name)]; ok {
+			return fmt.Errorf("expected %s header to not be set, got %s", name, val)
+		}
+	}
+	return nil
+}
+
+// GetTestCaseName gets the user-defined test case name or generates one from
+// the expected response to a given call.
+func (er *ExpectedResponse) GetTestCaseName(i int) string {
+	if er.TestCaseName != "" {
+		return er.TestCaseName
+	}
+
+	headerStr := ""
+	if er.Request.Headers != nil {
+		headerStr = " with headers"
+	}
+	method := er.Request.Method
+	if method == "" {
+		method = "Echo"
+	}
+	reqStr := fmt.Sprintf("%d call to '%s'%s", i, method, headerStr)
+
+	if er.Backend != "" {
+		return fmt.Sprintf("%s should go to %s", reqStr, er.Backend)
+	}
+	return fmt.Sprintf("%s should receive %s", reqStr, er.Response.Code)
+}
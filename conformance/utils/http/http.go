@@ -78,6 +78,11 @@ type ExpectedRequest struct {
 	// AbsentHeaders are names of headers that are expected
 	// *not* to be present on the request.
 	AbsentHeaders []string
+
+	// SNI is the server name expected in the TLS handshake
+	// of the request with the backend. When it is set, the
+	// request is expected to reach the backend over TLS.
+	SNI string
 }
 
 // Response defines expected properties of a response from a backend.
@@ -278,6 +283,14 @@ func CompareRequest(t *testing.T, req *roundtripper.Request, cReq *roundtripper.
 		if expected.Namespace != cReq.Namespace {
 			return fmt.Errorf("expected namespace to be %s, got %s", expected.Namespace, cReq.Namespace)
 		}
+		if expected.ExpectedRequest.SNI != "" {
+			if cReq.TLS == nil {
+				return fmt.Errorf("expected request to reach the backend over TLS, got plain text")
+			}
+			if expected.ExpectedRequest.SNI != cReq.TLS.ServerName {
+				return fmt.Errorf("expected SNI to be %s, got %s", expected.ExpectedRequest.SNI, cReq.TLS.ServerName)
+			}
+		}
 		if expected.ExpectedRequest.Headers != nil {
 			if cReq.Headers == nil {
 				return fmt.Errorf("no headers captured, expected %v", len(expected.ExpectedRequest.Headers))
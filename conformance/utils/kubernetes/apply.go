@@ -61,6 +61,103 @@ type Applier struct {
 	// UnusableNetworkAddresses is a list of addresses that are expected to be
 	// supported, but not usable for Gateways in the underlying implementation.
 	UnusableNetworkAddresses []v1beta1.GatewayAddress
+
+	// NamespaceMapping maps the namespaces used in manifests to the namespaces
+	// the resources are applied to. Both the namespace of the resources and
+	// the namespaces they reference are rewritten, allowing tests running in
+	// parallel to apply the same manifests to isolated namespaces.
+	NamespaceMapping map[string]string
+}
+
+// namespaceNameLabel is the label set by Kubernetes on every Namespace to
+// its name, commonly used in namespace selectors.
+const namespaceNameLabel = "kubernetes.io/metadata.name"
+
+// prepareNamespaceMapping rewrites the namespace of the resource, the name of
+// Namespace resources and any namespace referenced by the resource according
+// to the NamespaceMapping.
+func (a Applier) prepareNamespaceMapping(uObj *unstructured.Unstructured) {
+	if ns, ok := a.NamespaceMapping[uObj.GetNamespace()]; ok {
+		uObj.SetNamespace(ns)
+	}
+	if uObj.GetKind() == "Namespace" && uObj.GetObjectKind().GroupVersionKind().Group == "" {
+		if ns, ok := a.NamespaceMapping[uObj.GetName()]; ok {
+			uObj.SetName(ns)
+		}
+	}
+
+	for k, v := range uObj.Object {
+		if k == "apiVersion" || k == "kind" || k == "metadata" {
+			continue
+		}
+		uObj.Object[k] = a.mapNamespaceReferences(v)
+	}
+}
+
+// mapNamespaceReferences walks an unstructured value and rewrites the
+// namespaces it references. These are the values of "namespace" fields, as
+// found in parentRefs, backendRefs, certificateRefs and ReferenceGrants, as
+// well as namespace names matched by namespace selectors.
+func (a Applier) mapNamespaceReferences(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			switch key {
+			case "namespace":
+				if ns, ok := field.(string); ok {
+					if mapped, ok := a.NamespaceMapping[ns]; ok {
+						v[key] = mapped
+					}
+					continue
+				}
+			case "matchLabels":
+				if labels, ok := field.(map[string]interface{}); ok {
+					if ns, ok := labels[namespaceNameLabel].(string); ok {
+						if mapped, ok := a.NamespaceMapping[ns]; ok {
+							labels[namespaceNameLabel] = mapped
+						}
+					}
+				}
+				continue
+			case "matchExpressions":
+				if expressions, ok := field.([]interface{}); ok {
+					for _, e := range expressions {
+						expression, ok := e.(map[string]interface{})
+						if !ok || expression["key"] != namespaceNameLabel {
+							continue
+						}
+						expression["values"] = a.mapNamespaceValues(expression["values"])
+					}
+				}
+				continue
+			}
+			v[key] = a.mapNamespaceReferences(field)
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = a.mapNamespaceReferences(v[i])
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// mapNamespaceValues rewrites a list of namespace names.
+func (a Applier) mapNamespaceValues(value interface{}) interface{} {
+	values, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+	for i, v := range values {
+		if ns, ok := v.(string); ok {
+			if mapped, ok := a.NamespaceMapping[ns]; ok {
+				values[i] = mapped
+			}
+		}
+	}
+	return values
 }
 
 // prepareGateway adjusts the gatewayClassName.
@@ -196,6 +293,10 @@ func (a Applier) prepareResources(t *testing.T, decoder *yaml.YAMLOrJSONDecoder)
 			continue
 		}
 
+		if len(a.NamespaceMapping) > 0 {
+			a.prepareNamespaceMapping(&uObj)
+		}
+
 		if uObj.GetKind() == "GatewayClass" {
 			a.prepareGatewayClass(t, &uObj)
 		}
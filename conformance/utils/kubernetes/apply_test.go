@@ -168,6 +168,180 @@ spec:
 				},
 			},
 		}},
+	}, {
+		name: "mapping namespaces",
+		applier: Applier{
+			NamespaceMapping: map[string]string{
+				"gateway-conformance-infra": "gateway-conformance-infra-test",
+			},
+		},
+		given: `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: gateway-conformance-infra
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: test
+  namespace: gateway-conformance-infra
+spec:
+  parentRefs:
+  - name: same-namespace
+    namespace: gateway-conformance-infra
+  rules:
+  - backendRefs:
+    - name: infra-backend-v1
+      namespace: gateway-conformance-infra
+      port: 8080
+    - name: web-backend
+      namespace: gateway-conformance-web-backend
+      port: 8080
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: ReferenceGrant
+metadata:
+  name: test
+  namespace: gateway-conformance-web-backend
+spec:
+  from:
+  - group: gateway.networking.k8s.io
+    kind: HTTPRoute
+    namespace: gateway-conformance-infra
+  to:
+  - group: ""
+    kind: Service
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: test
+  namespace: gateway-conformance-infra
+spec:
+  gatewayClassName: {GATEWAY_CLASS_NAME}
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+    allowedRoutes:
+      namespaces:
+        from: Selector
+        selector:
+          matchLabels:
+            kubernetes.io/metadata.name: gateway-conformance-infra
+          matchExpressions:
+          - key: kubernetes.io/metadata.name
+            operator: In
+            values:
+            - gateway-conformance-infra
+            - gateway-conformance-app-backend
+`,
+		expected: []unstructured.Unstructured{{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata": map[string]interface{}{
+					"name": "gateway-conformance-infra-test",
+				},
+			},
+		}, {
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1beta1",
+				"kind":       "HTTPRoute",
+				"metadata": map[string]interface{}{
+					"name":      "test",
+					"namespace": "gateway-conformance-infra-test",
+				},
+				"spec": map[string]interface{}{
+					"parentRefs": []interface{}{
+						map[string]interface{}{
+							"name":      "same-namespace",
+							"namespace": "gateway-conformance-infra-test",
+						},
+					},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"backendRefs": []interface{}{
+								map[string]interface{}{
+									"name":      "infra-backend-v1",
+									"namespace": "gateway-conformance-infra-test",
+									"port":      int64(8080),
+								},
+								map[string]interface{}{
+									"name":      "web-backend",
+									"namespace": "gateway-conformance-web-backend",
+									"port":      int64(8080),
+								},
+							},
+						},
+					},
+				},
+			},
+		}, {
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1beta1",
+				"kind":       "ReferenceGrant",
+				"metadata": map[string]interface{}{
+					"name":      "test",
+					"namespace": "gateway-conformance-web-backend",
+				},
+				"spec": map[string]interface{}{
+					"from": []interface{}{
+						map[string]interface{}{
+							"group":     "gateway.networking.k8s.io",
+							"kind":      "HTTPRoute",
+							"namespace": "gateway-conformance-infra-test",
+						},
+					},
+					"to": []interface{}{
+						map[string]interface{}{
+							"group": "",
+							"kind":  "Service",
+						},
+					},
+				},
+			},
+		}, {
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1beta1",
+				"kind":       "Gateway",
+				"metadata": map[string]interface{}{
+					"name":      "test",
+					"namespace": "gateway-conformance-infra-test",
+				},
+				"spec": map[string]interface{}{
+					"gatewayClassName": "test-class",
+					"listeners": []interface{}{
+						map[string]interface{}{
+							"name":     "http",
+							"port":     int64(80),
+							"protocol": "HTTP",
+							"allowedRoutes": map[string]interface{}{
+								"namespaces": map[string]interface{}{
+									"from": "Selector",
+									"selector": map[string]interface{}{
+										"matchLabels": map[string]interface{}{
+											"kubernetes.io/metadata.name": "gateway-conformance-infra-test",
+										},
+										"matchExpressions": []interface{}{
+											map[string]interface{}{
+												"key":      "kubernetes.io/metadata.name",
+												"operator": "In",
+												"values": []interface{}{
+													"gateway-conformance-infra-test",
+													"gateway-conformance-app-backend",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}},
 	}}
 
 	for _, tc := range tests {
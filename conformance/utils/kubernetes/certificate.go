@@ -41,6 +41,10 @@ import (
 const (
 	rsaBits  = 2048
 	validFor = 365 * 24 * time.Hour
+
+	// CACertConfigMapKey is the key of the CA certificate in the ConfigMaps
+	// referenced by BackendTLSPolicies.
+	CACertConfigMapKey = "ca.crt"
 )
 
 // MustCreateSelfSignedCertSecret creates a self-signed SSL certificate and stores it in a secret
@@ -68,33 +72,96 @@ func MustCreateSelfSignedCertSecret(t *testing.T, namespace, secretName string,
 	return newSecret
 }
 
+// CertificateAuthority is a certificate authority generated for conformance
+// tests, used to sign the certificates of backends that a Gateway validates.
+type CertificateAuthority struct {
+	cert *x509.Certificate
+	key  *rsa.PrivateKey
+
+	// CertPEM is the PEM encoded certificate of the authority.
+	CertPEM []byte
+}
+
+// MustCreateCertificateAuthority creates a self-signed certificate authority
+// valid for a year.
+func MustCreateCertificateAuthority(t *testing.T) *CertificateAuthority {
+	priv, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	require.NoError(t, err, "failed to generate CA key")
+
+	template, err := certificateTemplate()
+	require.NoError(t, err, "failed to generate CA certificate template")
+	template.Subject.CommonName = "gateway-api-conformance-ca"
+	template.IsCA = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = nil
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	require.NoError(t, err, "failed to create CA certificate")
+	cert, err := x509.ParseCertificate(derBytes)
+	require.NoError(t, err, "failed to parse CA certificate")
+
+	return &CertificateAuthority{
+		cert:    cert,
+		key:     priv,
+		CertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}),
+	}
+}
+
+// MustCreateCASignedCertSecret creates an SSL certificate signed by the given
+// certificate authority and stores it in a secret
+func MustCreateCASignedCertSecret(t *testing.T, namespace, secretName string, hosts []string, ca *CertificateAuthority) *corev1.Secret {
+	require.Greater(t, len(hosts), 0, "require a non-empty hosts for Subject Alternate Name values")
+
+	var serverKey, serverCert bytes.Buffer
+
+	require.NoError(t, generateCASignedRSACert(hosts, ca, &serverKey, &serverCert), "failed to generate RSA certificate")
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      secretName,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       serverCert.Bytes(),
+			corev1.TLSPrivateKeyKey: serverKey.Bytes(),
+		},
+	}
+}
+
+// MustCreateCACertConfigMap creates a ConfigMap holding the certificate of the
+// given certificate authority in its ca.crt key, as expected by the
+// caCertRefs of a BackendTLSPolicy.
+func MustCreateCACertConfigMap(t *testing.T, namespace, configMapName string, ca *CertificateAuthority) *corev1.ConfigMap {
+	require.NotNil(t, ca, "require a certificate authority")
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      configMapName,
+		},
+		Data: map[string]string{
+			CACertConfigMapKey: string(ca.CertPEM),
+		},
+	}
+}
+
 // generateRSACert generates a basic self signed certificate valid for a year
 func generateRSACert(hosts []string, keyOut, certOut io.Writer) error {
+	return generateCASignedRSACert(hosts, nil, keyOut, certOut)
+}
+
+// generateCASignedRSACert generates a basic certificate valid for a year,
+// signed by the certificate authority or self signed if it is nil.
+func generateCASignedRSACert(hosts []string, ca *CertificateAuthority, keyOut, certOut io.Writer) error {
 	priv, err := rsa.GenerateKey(rand.Reader, rsaBits)
 	if err != nil {
 		return fmt.Errorf("failed to generate key: %w", err)
 	}
-	notBefore := time.Now()
-	notAfter := notBefore.Add(validFor)
 
-	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
-	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	template, err := certificateTemplate()
 	if err != nil {
-		return fmt.Errorf("failed to generate serial number: %w", err)
-	}
-
-	template := x509.Certificate{
-		SerialNumber: serialNumber,
-		Subject: pkix.Name{
-			CommonName:   "default",
-			Organization: []string{"Acme Co"},
-		},
-		NotBefore: notBefore,
-		NotAfter:  notAfter,
-
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
-		BasicConstraintsValid: true,
+		return err
 	}
 
 	for _, h := range hosts {
@@ -105,7 +172,11 @@ func generateRSACert(hosts []string, keyOut, certOut io.Writer) error {
 		}
 	}
 
-	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	parent, signer := template, priv
+	if ca != nil {
+		parent, signer = ca.cert, ca.key
+	}
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, parent, &priv.PublicKey, signer)
 	if err != nil {
 		return fmt.Errorf("failed to create certificate: %w", err)
 	}
@@ -120,3 +191,30 @@ func generateRSACert(hosts []string, keyOut, certOut io.Writer) error {
 
 	return nil
 }
+
+// certificateTemplate returns the template of a server certificate valid for
+// a year.
+func certificateTemplate() (*x509.Certificate, error) {
+	notBefore := time.Now()
+	notAfter := notBefore.Add(validFor)
+
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName:   "default",
+			Organization: []string{"Acme Co"},
+		},
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}, nil
+}
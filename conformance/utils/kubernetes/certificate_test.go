@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestMustCreateCASignedCertSecret(t *testing.T) {
+	ca := MustCreateCertificateAuthority(t)
+	otherCA := MustCreateCertificateAuthority(t)
+
+	secret := MustCreateCASignedCertSecret(t, "gateway-conformance-infra", "backend-tls", []string{"abc.example.com"}, ca)
+	assert.Equal(t, "gateway-conformance-infra", secret.Namespace)
+	assert.Equal(t, "backend-tls", secret.Name)
+	assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+
+	configMap := MustCreateCACertConfigMap(t, "gateway-conformance-infra", "backend-tls-ca", ca)
+	assert.Equal(t, "backend-tls-ca", configMap.Name)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM([]byte(configMap.Data[CACertConfigMapKey])))
+
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: "abc.example.com", Roots: roots})
+	assert.NoError(t, err, "expected the certificate to be signed by the CA of the ConfigMap")
+
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: "other.example.com", Roots: roots})
+	assert.Error(t, err, "expected the certificate not to be valid for other hosts")
+
+	otherRoots := x509.NewCertPool()
+	require.True(t, otherRoots.AppendCertsFromPEM(otherCA.CertPEM))
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: "abc.example.com", Roots: otherRoots})
+	assert.Error(t, err, "expected the certificate not to be signed by another CA")
+}
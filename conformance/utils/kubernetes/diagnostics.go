@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// diagnosticsKinds are the kinds of the Gateway API resources dumped by
+// DumpResources.
+var diagnosticsKinds = []schema.GroupVersionKind{
+	{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "Gateway"},
+	{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"},
+	{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "GRPCRoute"},
+	{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TLSRoute"},
+	{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TCPRoute"},
+	{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "UDPRoute"},
+	{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "BackendTLSPolicy"},
+	{Group: "gateway.networking.k8s.io", Version: "v1beta1", Kind: "ReferenceGrant"},
+}
+
+// DumpResources returns the YAML of the Gateways, routes, policies and
+// ReferenceGrants in the given namespaces, including their status. Kinds
+// whose CRD isn't installed are skipped.
+func DumpResources(ctx context.Context, c client.Client, namespaces []string) ([]byte, error) {
+	return dumpObjects(ctx, c, diagnosticsKinds, namespaces)
+}
+
+// DumpEvents returns the YAML of the Events in the given namespaces.
+func DumpEvents(ctx context.Context, c client.Client, namespaces []string) ([]byte, error) {
+	return dumpObjects(ctx, c, []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("Event")}, namespaces)
+}
+
+// dumpObjects returns the YAML of the objects of the given kinds in the given
+// namespaces, as a stream of YAML documents ordered by namespace, kind and
+// name.
+func dumpObjects(ctx context.Context, c client.Client, kinds []schema.GroupVersionKind, namespaces []string) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, ns := range namespaces {
+		for _, gvk := range kinds {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err := c.List(ctx, list, client.InNamespace(ns)); err != nil {
+				if meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("error listing %s in namespace %s: %w", gvk.Kind, ns, err)
+			}
+
+			sort.Slice(list.Items, func(i, j int) bool {
+				return list.Items[i].GetName() < list.Items[j].GetName()
+			})
+			for _, item := range list.Items {
+				item.SetManagedFields(nil)
+				data, err := yaml.Marshal(item.Object)
+				if err != nil {
+					return nil, fmt.Errorf("error marshaling %s %s/%s: %w", gvk.Kind, ns, item.GetName(), err)
+				}
+				buf.WriteString("---\n")
+				buf.Write(data)
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestDumpResources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1.AddToScheme(scheme))
+
+	gateway := func(ns, name string) *v1.Gateway {
+		return &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:     ns,
+				Name:          name,
+				ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "conformance"}},
+			},
+			Spec: v1.GatewaySpec{GatewayClassName: "example"},
+			Status: v1.GatewayStatus{
+				Conditions: []metav1.Condition{{Type: "Programmed", Status: metav1.ConditionFalse, Reason: "Pending"}},
+			},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		gateway("gateway-conformance-infra", "same-namespace"),
+		gateway("gateway-conformance-infra", "all-namespaces"),
+		gateway("other", "ignored"),
+		&v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "gateway-conformance-infra", Name: "route"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Namespace: "gateway-conformance-infra", Name: "event"}, Reason: "Failed"},
+	).Build()
+
+	resources, err := DumpResources(context.Background(), c, []string{"gateway-conformance-infra"})
+	require.NoError(t, err, "kinds that are not registered must be skipped")
+	dump := string(resources)
+	assert.Contains(t, dump, "name: all-namespaces")
+	assert.Contains(t, dump, "name: same-namespace")
+	assert.Contains(t, dump, "kind: HTTPRoute")
+	assert.Contains(t, dump, "reason: Pending", "the status must be dumped")
+	assert.NotContains(t, dump, "name: ignored")
+	assert.NotContains(t, dump, "managedFields")
+	assert.NotContains(t, dump, "kind: Event")
+	assert.Less(t, strings.Index(dump, "name: all-namespaces"), strings.Index(dump, "name: same-namespace"))
+	assert.Less(t, strings.Index(dump, "name: same-namespace"), strings.Index(dump, "kind: HTTPRoute"))
+
+	events, err := DumpEvents(context.Background(), c, []string{"gateway-conformance-infra"})
+	require.NoError(t, err)
+	assert.Contains(t, string(events), "reason: Failed")
+}
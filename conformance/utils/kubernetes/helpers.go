@@ -788,6 +788,159 @@ func TLSRouteMustHaveCondition(t *testing.T, client client.Client, timeoutConfig
 	require.NoErrorf(t, waitErr, "error waiting for TLSRoute status to have a Condition matching expectations")
 }
 
+// GatewayAndGRPCRoutesMustBeAccepted waits until the specified Gateway has an IP
+// address assigned to it and the GRPCRoutes have a ParentRef referring to the
+// Gateway. The test will fail if these conditions are not met before the
+// timeouts.
+func GatewayAndGRPCRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, controllerName string, gw GatewayRef, routeNNs ...types.NamespacedName) string {
+	t.Helper()
+
+	gwAddr, err := WaitForGatewayAddress(t, c, timeoutConfig, gw.NamespacedName)
+	require.NoErrorf(t, err, "timed out waiting for Gateway address to be assigned")
+
+	parents := acceptedRouteParents(controllerName, gw)
+	for _, routeNN := range routeNNs {
+		GRPCRouteMustHaveParents(t, c, timeoutConfig, routeNN, parents, routeNN.Namespace != gw.Namespace)
+	}
+
+	return gwAddr
+}
+
+// GatewayAndTCPRoutesMustBeAccepted waits until the specified Gateway has an IP
+// address assigned to it and the TCPRoutes have a ParentRef referring to the
+// Gateway. The test will fail if these conditions are not met before the
+// timeouts.
+func GatewayAndTCPRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, controllerName string, gw GatewayRef, routeNNs ...types.NamespacedName) string {
+	t.Helper()
+
+	gwAddr, err := WaitForGatewayAddress(t, c, timeoutConfig, gw.NamespacedName)
+	require.NoErrorf(t, err, "timed out waiting for Gateway address to be assigned")
+
+	parents := acceptedRouteParents(controllerName, gw)
+	for _, routeNN := range routeNNs {
+		TCPRouteMustHaveParents(t, c, timeoutConfig, routeNN, parents, routeNN.Namespace != gw.Namespace)
+	}
+
+	return gwAddr
+}
+
+// GatewayAndUDPRoutesMustBeAccepted waits until the specified Gateway has an IP
+// address assigned to it and the UDPRoutes have a ParentRef referring to the
+// Gateway. The test will fail if these conditions are not met before the
+// timeouts.
+func GatewayAndUDPRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, controllerName string, gw GatewayRef, routeNNs ...types.NamespacedName) string {
+	t.Helper()
+
+	gwAddr, err := WaitForGatewayAddress(t, c, timeoutConfig, gw.NamespacedName)
+	require.NoErrorf(t, err, "timed out waiting for Gateway address to be assigned")
+
+	parents := acceptedRouteParents(controllerName, gw)
+	for _, routeNN := range routeNNs {
+		UDPRouteMustHaveParents(t, c, timeoutConfig, routeNN, parents, routeNN.Namespace != gw.Namespace)
+	}
+
+	return gwAddr
+}
+
+// acceptedRouteParents returns the parent statuses expected on a Route
+// accepted by each of the listeners of the Gateway.
+func acceptedRouteParents(controllerName string, gw GatewayRef) []gatewayv1.RouteParentStatus {
+	ns := gatewayv1.Namespace(gw.Namespace)
+	kind := gatewayv1.Kind("Gateway")
+
+	var parents []gatewayv1.RouteParentStatus
+	for _, listener := range gw.listenerNames {
+		parents = append(parents, gatewayv1.RouteParentStatus{
+			ParentRef: gatewayv1.ParentReference{
+				Group:       (*gatewayv1.Group)(&gatewayv1.GroupVersion.Group),
+				Kind:        &kind,
+				Name:        gatewayv1.ObjectName(gw.Name),
+				Namespace:   &ns,
+				SectionName: listener,
+			},
+			ControllerName: gatewayv1.GatewayController(controllerName),
+			Conditions: []metav1.Condition{{
+				Type:   string(gatewayv1.RouteConditionAccepted),
+				Status: metav1.ConditionTrue,
+				Reason: string(gatewayv1.RouteReasonAccepted),
+			}},
+		})
+	}
+	return parents
+}
+
+// GRPCRouteMustHaveParents waits for the specified GRPCRoute to have parents
+// in status that match the expected parents, and also returns the GRPCRoute.
+// This will cause the test to halt if the specified timeout is exceeded.
+func GRPCRouteMustHaveParents(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, routeName types.NamespacedName, parents []v1alpha2.RouteParentStatus, namespaceRequired bool) v1alpha2.GRPCRoute {
+	t.Helper()
+
+	var actual []gatewayv1.RouteParentStatus
+	var route v1alpha2.GRPCRoute
+
+	waitErr := wait.PollUntilContextTimeout(context.Background(), 1*time.Second, timeoutConfig.RouteMustHaveParents, true, func(ctx context.Context) (bool, error) {
+		err := client.Get(ctx, routeName, &route)
+		if err != nil {
+			return false, fmt.Errorf("error fetching GRPCRoute: %w", err)
+		}
+		actual = route.Status.Parents
+		match := parentsForRouteMatch(t, routeName, parents, actual, namespaceRequired)
+
+		return match, nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for GRPCRoute to have parents matching expectations")
+
+	return route
+}
+
+// TCPRouteMustHaveParents waits for the specified TCPRoute to have parents
+// in status that match the expected parents, and also returns the TCPRoute.
+// This will cause the test to halt if the specified timeout is exceeded.
+func TCPRouteMustHaveParents(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, routeName types.NamespacedName, parents []v1alpha2.RouteParentStatus, namespaceRequired bool) v1alpha2.TCPRoute {
+	t.Helper()
+
+	var actual []gatewayv1.RouteParentStatus
+	var route v1alpha2.TCPRoute
+
+	waitErr := wait.PollUntilContextTimeout(context.Background(), 1*time.Second, timeoutConfig.RouteMustHaveParents, true, func(ctx context.Context) (bool, error) {
+		err := client.Get(ctx, routeName, &route)
+		if err != nil {
+			return false, fmt.Errorf("error fetching TCPRoute: %w", err)
+		}
+		actual = route.Status.Parents
+		match := parentsForRouteMatch(t, routeName, parents, actual, namespaceRequired)
+
+		return match, nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for TCPRoute to have parents matching expectations")
+
+	return route
+}
+
+// UDPRouteMustHaveParents waits for the specified UDPRoute to have parents
+// in status that match the expected parents, and also returns the UDPRoute.
+// This will cause the test to halt if the specified timeout is exceeded.
+func UDPRouteMustHaveParents(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, routeName types.NamespacedName, parents []v1alpha2.RouteParentStatus, namespaceRequired bool) v1alpha2.UDPRoute {
+	t.Helper()
+
+	var actual []gatewayv1.RouteParentStatus
+	var route v1alpha2.UDPRoute
+
+	waitErr := wait.PollUntilContextTimeout(context.Background(), 1*time.Second, timeoutConfig.RouteMustHaveParents, true, func(ctx context.Context) (bool, error) {
+		err := client.Get(ctx, routeName, &route)
+		if err != nil {
+			return false, fmt.Errorf("error fetching UDPRoute: %w", err)
+		}
+		actual = route.Status.Parents
+		match := parentsForRouteMatch(t, routeName, parents, actual, namespaceRequired)
+
+		return match, nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for UDPRoute to have parents matching expectations")
+
+	return route
+}
+
 // TODO(mikemorris): this and parentsMatch could possibly be rewritten as a generic function?
 func listenersMatch(t *testing.T, expected, actual []gatewayv1.ListenerStatus) bool {
 	t.Helper()
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package l4
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+// ExpectedResponse defines the response expected for a given TCP or UDP
+// payload.
+type ExpectedResponse struct {
+	// Request defines the payload to send.
+	Request Request
+
+	// Unreachable indicates that the payload is expected not to reach any
+	// backend, the connection being refused, reset or left unanswered.
+	Unreachable bool
+
+	Backend   string
+	Namespace string
+
+	// User Given TestCase name
+	TestCaseName string
+}
+
+// Request defines the payload to send to a Gateway.
+type Request struct {
+	// Protocol is either "TCP" or "UDP".
+	Protocol string
+	// Port overrides the port of the Gateway address when it is set.
+	Port int
+	// Payload is the line sent to the backend, it defaults to a payload
+	// unique to the request.
+	Payload string
+}
+
+// MakeRequestAndExpectEventuallyConsistentResponse sends a payload with the
+// given parameters, understanding that the payload may fail to reach the
+// expected backend for some amount of time.
+func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, r roundtripper.L4RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse) {
+	t.Helper()
+
+	req := MakeRequest(t, &expected, gwAddr)
+
+	WaitForConsistentResponse(t, r, req, expected, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig.MaxTimeToConsistency)
+}
+
+// MakeRequest builds the L4 request described by the expected response,
+// setting defaults on it.
+func MakeRequest(t *testing.T, expected *ExpectedResponse, gwAddr string) roundtripper.L4Request {
+	t.Helper()
+
+	if expected.Request.Protocol == "" {
+		expected.Request.Protocol = "TCP"
+	}
+	if expected.Request.Payload == "" {
+		expected.Request.Payload = fmt.Sprintf("gateway-api-conformance-%d", time.Now().UnixNano())
+	}
+
+	addr := gwAddr
+	if expected.Request.Port != 0 {
+		addr = AddressWithPort(t, gwAddr, expected.Request.Port)
+	}
+
+	t.Logf("Sending %s payload to %s", expected.Request.Protocol, addr)
+
+	return roundtripper.L4Request{
+		Protocol: expected.Request.Protocol,
+		Address:  addr,
+		Payload:  expected.Request.Payload,
+	}
+}
+
+// AddressWithPort replaces the port of a Gateway address.
+func AddressWithPort(t *testing.T, gwAddr string, port int) string {
+	t.Helper()
+
+	host, _, err := net.SplitHostPort(gwAddr)
+	if err != nil {
+		// The address has no port.
+		host = strings.Trim(gwAddr, "[]")
+	}
+	return net.JoinHostPort(host, fmt.Sprint(port))
+}
+
+// WaitForConsistentResponse repeats the provided request until it completes
+// with the expected response consistently. The provided threshold
+// determines how many times in a row this must occur to be considered
+// "consistent".
+func WaitForConsistentResponse(t *testing.T, r roundtripper.L4RoundTripper, req roundtripper.L4Request, expected ExpectedResponse, threshold int, maxTimeToConsistency time.Duration) {
+	http.AwaitConvergence(t, threshold, maxTimeToConsistency, func(elapsed time.Duration) bool {
+		cRes, err := r.CaptureL4RoundTrip(req)
+		if expected.Unreachable {
+			if err == nil {
+				t.Logf("%s payload reached pod %s, expected it to be unreachable (after %v)", req.Protocol, cRes.Pod, elapsed)
+				return false
+			}
+			return true
+		}
+		if err != nil {
+			t.Logf("%s request failed, not ready yet: %v (after %v)", req.Protocol, err.Error(), elapsed)
+			return false
+		}
+
+		if err := CompareResponse(&req, cRes, expected); err != nil {
+			t.Logf("Response expectation failed for %s request: %+v  not ready yet: %v (after %v)", req.Protocol, req, err, elapsed)
+			return false
+		}
+
+		return true
+	})
+	t.Logf("%s request passed", req.Protocol)
+}
+
+// CompareResponse checks that the response of the echo listener matches the
+// expected response.
+func CompareResponse(req *roundtripper.L4Request, cRes *roundtripper.CapturedL4Response, expected ExpectedResponse) error {
+	if !strings.EqualFold(cRes.Protocol, req.Protocol) {
+		return fmt.Errorf("expected protocol to be %s, got %s", req.Protocol, cRes.Protocol)
+	}
+	if cRes.Payload != req.Payload {
+		return fmt.Errorf("expected payload to be %q, got %q", req.Payload, cRes.Payload)
+	}
+	if expected.Namespace != cRes.Namespace {
+		return fmt.Errorf("expected namespace to be %s, got %s", expected.Namespace, cRes.Namespace)
+	}
+	if !strings.HasPrefix(cRes.Pod, expected.Backend) {
+		return fmt.Errorf("expected pod name to start with %s, got %s", expected.Backend, cRes.Pod)
+	}
+	return nil
+}
+
+// GetTestCaseName gets the user-defined test case name or generates one from
+// the expected response to a given request.
+func (er *ExpectedResponse) GetTestCaseName(i int) string {
+	if er.TestCaseName != "" {
+		return er.TestCaseName
+	}
+
+	protocol := er.Request.Protocol
+	if protocol == "" {
+		protocol = "TCP"
+	}
+	reqStr := fmt.Sprintf("%d %s request", i, protocol)
+	if er.Request.Port != 0 {
+		reqStr = fmt.Sprintf("%s to port %d", reqStr, er.Request.Port)
+	}
+
+	if er.Unreachable {
+		return fmt.Sprintf("%s should not reach any backend", reqStr)
+	}
+	return fmt.Sprintf("%s should go to %s", reqStr, er.Backend)
+}
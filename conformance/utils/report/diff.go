@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	confv1a2 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha2"
+)
+
+// Diff lists the changes between two conformance reports, per profile.
+type Diff struct {
+	Profiles []ProfileDiff
+}
+
+// ProfileDiff lists the changes of a conformance profile between two
+// reports.
+type ProfileDiff struct {
+	Name string
+
+	// Added and Removed are set when the profile is only in the new or the
+	// old report.
+	Added   bool
+	Removed bool
+
+	CoreResult     *ResultChange
+	ExtendedResult *ResultChange
+
+	NewlyFailingTests []string
+	NewlyPassingTests []string
+
+	SupportedFeaturesAdded   []string
+	SupportedFeaturesRemoved []string
+}
+
+// ResultChange is a change of the result of a support level.
+type ResultChange struct {
+	Old confv1a2.Result
+	New confv1a2.Result
+}
+
+// resultRanks orders results from worst to best, an unset result being the
+// worst.
+var resultRanks = map[confv1a2.Result]int{
+	confv1a2.Failure: 1,
+	confv1a2.Partial: 2,
+	confv1a2.Success: 3,
+}
+
+// IsRegression returns whether the new result is worse than the old one.
+func (c *ResultChange) IsRegression() bool {
+	return c != nil && resultRanks[c.New] < resultRanks[c.Old]
+}
+
+// IsRegression returns whether the profile regressed: it was removed, a test
+// newly fails, a supported feature was removed or a result got worse.
+func (d ProfileDiff) IsRegression() bool {
+	return d.Removed ||
+		len(d.NewlyFailingTests) > 0 ||
+		len(d.SupportedFeaturesRemoved) > 0 ||
+		d.CoreResult.IsRegression() ||
+		d.ExtendedResult.IsRegression()
+}
+
+// HasChanges returns whether anything changed for the profile.
+func (d ProfileDiff) HasChanges() bool {
+	return d.Added || d.Removed ||
+		d.CoreResult != nil || d.ExtendedResult != nil ||
+		len(d.NewlyFailingTests) > 0 || len(d.NewlyPassingTests) > 0 ||
+		len(d.SupportedFeaturesAdded) > 0 || len(d.SupportedFeaturesRemoved) > 0
+}
+
+// HasRegressions returns whether any profile regressed.
+func (d Diff) HasRegressions() bool {
+	for _, profile := range d.Profiles {
+		if profile.IsRegression() {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare lists the changes from the old to the new report.
+func Compare(oldReport, newReport *confv1a2.ConformanceReport) Diff {
+	oldProfiles := profilesByName(oldReport)
+	newProfiles := profilesByName(newReport)
+
+	names := sets.KeySet(oldProfiles).Union(sets.KeySet(newProfiles))
+	diff := Diff{}
+	for _, name := range sets.List(names) {
+		oldProfile, inOld := oldProfiles[name]
+		newProfile, inNew := newProfiles[name]
+		profileDiff := ProfileDiff{Name: name}
+		switch {
+		case !inOld:
+			profileDiff.Added = true
+		case !inNew:
+			profileDiff.Removed = true
+		default:
+			profileDiff.CoreResult = resultChange(oldProfile.Core.Result, newProfile.Core.Result)
+			profileDiff.ExtendedResult = resultChange(extendedResult(oldProfile), extendedResult(newProfile))
+
+			oldFailing := unsuccessfulTests(oldProfile)
+			newFailing := unsuccessfulTests(newProfile)
+			profileDiff.NewlyFailingTests = sets.List(failedTests(newProfile).Difference(failedTests(oldProfile)))
+			profileDiff.NewlyPassingTests = sets.List(passedTests(newProfile, oldFailing.Difference(newFailing)))
+
+			oldFeatures, newFeatures := supportedFeatures(oldProfile), supportedFeatures(newProfile)
+			profileDiff.SupportedFeaturesAdded = sets.List(newFeatures.Difference(oldFeatures))
+			profileDiff.SupportedFeaturesRemoved = sets.List(oldFeatures.Difference(newFeatures))
+		}
+		diff.Profiles = append(diff.Profiles, profileDiff)
+	}
+	return diff
+}
+
+// Print writes a human readable summary of the changes.
+func (d Diff) Print(w io.Writer) error {
+	var b strings.Builder
+	for _, profile := range d.Profiles {
+		if !profile.HasChanges() {
+			continue
+		}
+		fmt.Fprintf(&b, "Profile %s:\n", profile.Name)
+		switch {
+		case profile.Added:
+			b.WriteString("  profile added\n")
+			continue
+		case profile.Removed:
+			b.WriteString("  profile removed\n")
+			continue
+		}
+		if profile.CoreResult != nil {
+			fmt.Fprintf(&b, "  core result: %s -> %s\n", resultString(profile.CoreResult.Old), resultString(profile.CoreResult.New))
+		}
+		if profile.ExtendedResult != nil {
+			fmt.Fprintf(&b, "  extended result: %s -> %s\n", resultString(profile.ExtendedResult.Old), resultString(profile.ExtendedResult.New))
+		}
+		writeList(&b, "newly failing tests", profile.NewlyFailingTests)
+		writeList(&b, "newly passing tests", profile.NewlyPassingTests)
+		writeList(&b, "supported features added", profile.SupportedFeaturesAdded)
+		writeList(&b, "supported features removed", profile.SupportedFeaturesRemoved)
+	}
+	if b.Len() == 0 {
+		b.WriteString("No changes\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeList(b *strings.Builder, title string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "  %s:\n", title)
+	for _, v := range values {
+		fmt.Fprintf(b, "  - %s\n", v)
+	}
+}
+
+func resultString(r confv1a2.Result) string {
+	if r == "" {
+		return "none"
+	}
+	return string(r)
+}
+
+func resultChange(oldResult, newResult confv1a2.Result) *ResultChange {
+	if oldResult == newResult {
+		return nil
+	}
+	return &ResultChange{Old: oldResult, New: newResult}
+}
+
+func profilesByName(report *confv1a2.ConformanceReport) map[string]confv1a2.ProfileReport {
+	profiles := make(map[string]confv1a2.ProfileReport, len(report.ProfileReports))
+	for _, profile := range report.ProfileReports {
+		profiles[profile.Name] = profile
+	}
+	return profiles
+}
+
+func extendedResult(profile confv1a2.ProfileReport) confv1a2.Result {
+	if profile.Extended == nil {
+		return ""
+	}
+	return profile.Extended.Result
+}
+
+func statuses(profile confv1a2.ProfileReport) []confv1a2.Status {
+	s := []confv1a2.Status{profile.Core}
+	if profile.Extended != nil {
+		s = append(s, profile.Extended.Status)
+	}
+	return s
+}
+
+func failedTests(profile confv1a2.ProfileReport) sets.Set[string] {
+	tests := sets.New[string]()
+	for _, status := range statuses(profile) {
+		tests.Insert(status.FailedTests...)
+	}
+	return tests
+}
+
+// unsuccessfulTests returns the tests which failed or were skipped.
+func unsuccessfulTests(profile confv1a2.ProfileReport) sets.Set[string] {
+	tests := failedTests(profile)
+	for _, status := range statuses(profile) {
+		tests.Insert(status.SkippedTests...)
+	}
+	return tests
+}
+
+// passedTests returns which of the given tests passed in the profile.
+// v1alpha1 reports don't list passed tests, so when no passed test is listed
+// the tests are assumed to have passed.
+func passedTests(profile confv1a2.ProfileReport, tests sets.Set[string]) sets.Set[string] {
+	passed := sets.New[string]()
+	for _, status := range statuses(profile) {
+		for _, test := range status.Tests {
+			if test.Result == confv1a2.TestPassed {
+				passed.Insert(test.Name)
+			}
+		}
+	}
+	if passed.Len() == 0 {
+		return tests
+	}
+	return tests.Intersection(passed)
+}
+
+func supportedFeatures(profile confv1a2.ProfileReport) sets.Set[string] {
+	if profile.Extended == nil {
+		return sets.New[string]()
+	}
+	return sets.New(profile.Extended.SupportedFeatures...)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const oldReport = `
+apiVersion: gateway.networking.k8s.io/v1alpha1
+kind: ConformanceReport
+date: "2023-10-01T10:00:00Z"
+gatewayAPIVersion: v1.0.0
+implementation:
+  organization: example
+  project: gateway
+  url: https://example.com/gateway
+  version: v1.0.0
+  contact:
+  - '@example/maintainers'
+profiles:
+- name: HTTP
+  core:
+    result: failure
+    statistics:
+      Failed: 1
+      Passed: 30
+      Skipped: 0
+    failedTests:
+    - HTTPRouteHeaderMatching
+  extended:
+    result: success
+    statistics:
+      Failed: 0
+      Passed: 2
+      Skipped: 0
+    supportedFeatures:
+    - HTTPRouteMethodMatching
+    - HTTPRouteQueryParamMatching
+- name: TLS
+  core:
+    result: success
+    statistics:
+      Failed: 0
+      Passed: 10
+      Skipped: 0
+`
+
+const newReport = `
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: ConformanceReport
+date: "2023-11-01T10:00:00Z"
+gatewayAPIVersion: v1.0.0
+implementation:
+  organization: example
+  project: gateway
+  url: https://example.com/gateway
+  version: v1.1.0
+  contact:
+  - '@example/maintainers'
+profiles:
+- name: HTTP
+  core:
+    result: success
+    statistics:
+      Failed: 0
+      Passed: 31
+      Skipped: 0
+    tests:
+    - name: HTTPRouteHeaderMatching
+      result: passed
+      duration: 2s
+  extended:
+    result: failure
+    statistics:
+      Failed: 1
+      Passed: 1
+      Skipped: 0
+    failedTests:
+    - HTTPRouteMethodMatching
+    supportedFeatures:
+    - HTTPRouteMethodMatching
+    - HTTPRoutePathRewrite
+- name: GRPC
+  core:
+    result: success
+    statistics:
+      Failed: 0
+      Passed: 6
+      Skipped: 0
+`
+
+func TestCompare(t *testing.T) {
+	oldR, err := Parse([]byte(oldReport))
+	require.NoError(t, err)
+	newR, err := Parse([]byte(newReport))
+	require.NoError(t, err)
+
+	diff := Compare(oldR, newR)
+	require.Len(t, diff.Profiles, 3)
+
+	grpc, http, tls := diff.Profiles[0], diff.Profiles[1], diff.Profiles[2]
+	assert.Equal(t, ProfileDiff{Name: "GRPC", Added: true}, grpc)
+	assert.False(t, grpc.IsRegression())
+	assert.Equal(t, ProfileDiff{Name: "TLS", Removed: true}, tls)
+	assert.True(t, tls.IsRegression())
+
+	assert.Equal(t, ProfileDiff{
+		Name:                     "HTTP",
+		CoreResult:               &ResultChange{Old: "failure", New: "success"},
+		ExtendedResult:           &ResultChange{Old: "success", New: "failure"},
+		NewlyFailingTests:        []string{"HTTPRouteMethodMatching"},
+		NewlyPassingTests:        []string{"HTTPRouteHeaderMatching"},
+		SupportedFeaturesAdded:   []string{"HTTPRoutePathRewrite"},
+		SupportedFeaturesRemoved: []string{"HTTPRouteQueryParamMatching"},
+	}, http)
+	assert.True(t, http.IsRegression())
+	assert.True(t, diff.HasRegressions())
+
+	var out strings.Builder
+	require.NoError(t, diff.Print(&out))
+	assert.Equal(t, `Profile GRPC:
+  profile added
+Profile HTTP:
+  core result: failure -> success
+  extended result: success -> failure
+  newly failing tests:
+  - HTTPRouteMethodMatching
+  newly passing tests:
+  - HTTPRouteHeaderMatching
+  supported features added:
+  - HTTPRoutePathRewrite
+  supported features removed:
+  - HTTPRouteQueryParamMatching
+Profile TLS:
+  profile removed
+`, out.String())
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	r, err := Parse([]byte(oldReport))
+	require.NoError(t, err)
+
+	diff := Compare(r, r)
+	assert.False(t, diff.HasRegressions())
+
+	var out strings.Builder
+	require.NoError(t, diff.Print(&out))
+	assert.Equal(t, "No changes\n", out.String())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package report reads conformance reports back, to compare and validate
+// them.
+package report
+
+import (
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+	confv1a2 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha2"
+)
+
+const conformanceReportKind = "ConformanceReport"
+
+// Load reads a conformance report from a YAML file. v1alpha1 reports are
+// converted to v1alpha2.
+func Load(path string) (*confv1a2.ConformanceReport, error) {
+	return load(path, Parse)
+}
+
+// LoadStrict reads a conformance report from a YAML file like Load, but
+// fails on unknown or duplicate fields.
+func LoadStrict(path string) (*confv1a2.ConformanceReport, error) {
+	return load(path, ParseStrict)
+}
+
+func load(path string, parse func([]byte) (*confv1a2.ConformanceReport, error)) (*confv1a2.ConformanceReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	report, err := parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return report, nil
+}
+
+// Parse decodes a YAML conformance report. v1alpha1 reports are converted to
+// v1alpha2.
+func Parse(data []byte) (*confv1a2.ConformanceReport, error) {
+	return parse(data, false)
+}
+
+// ParseStrict decodes a YAML conformance report like Parse, but fails on
+// unknown or duplicate fields.
+func ParseStrict(data []byte) (*confv1a2.ConformanceReport, error) {
+	return parse(data, true)
+}
+
+func parse(data []byte, strict bool) (*confv1a2.ConformanceReport, error) {
+	unmarshal := func(obj interface{}) error {
+		if strict {
+			return yaml.UnmarshalStrict(data, obj)
+		}
+		return yaml.Unmarshal(data, obj)
+	}
+
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, err
+	}
+	if typeMeta.Kind != conformanceReportKind {
+		return nil, fmt.Errorf("unexpected kind %q, expected %s", typeMeta.Kind, conformanceReportKind)
+	}
+
+	switch typeMeta.APIVersion {
+	case "gateway.networking.k8s.io/v1alpha1":
+		report := &confv1a1.ConformanceReport{}
+		if err := unmarshal(report); err != nil {
+			return nil, err
+		}
+		return confv1a2.ConvertFromV1Alpha1(report), nil
+	case confv1a2.GroupVersion:
+		report := &confv1a2.ConformanceReport{}
+		if err := unmarshal(report); err != nil {
+			return nil, err
+		}
+		return report, nil
+	default:
+		return nil, fmt.Errorf("unsupported apiVersion %q", typeMeta.APIVersion)
+	}
+}
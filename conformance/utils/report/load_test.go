@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+const reportsDir = "../../reports"
+
+// legacyReports are the submitted reports, by path in the reports directory,
+// which aren't valid and are exempted from validation. They are historical
+// records: corrections must come from their owners, who can then remove them
+// from this list.
+var legacyReports = map[string]string{
+	"v0.7.1/kong-kubernetes-ingress-controller.yaml": "its profiles are listed under profileReports instead of profiles",
+}
+
+func TestLoadSubmittedReports(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(reportsDir, "*", "*.yaml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		_, err := Load(file)
+		assert.NoError(t, err)
+	}
+}
+
+func TestValidateSubmittedReports(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(reportsDir, "*", "*.yaml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		name, err := filepath.Rel(reportsDir, file)
+		require.NoError(t, err)
+		name = filepath.ToSlash(name)
+
+		report, err := LoadStrict(file)
+		var errs field.ErrorList
+		if err == nil {
+			errs = suite.ValidateConformanceReport(report)
+		}
+		if reason, ok := legacyReports[name]; ok {
+			t.Logf("%s is exempted from validation: %s", name, reason)
+			assert.True(t, err != nil || len(errs) > 0, "%s is valid and must be removed from the legacy reports", name)
+			continue
+		}
+		assert.NoError(t, err, name)
+		assert.Empty(t, errs, name)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse([]byte("apiVersion: v1\nkind: ConfigMap\n"))
+	assert.ErrorContains(t, err, `unexpected kind "ConfigMap"`)
+
+	_, err = Parse([]byte("apiVersion: gateway.networking.k8s.io/v1\nkind: ConformanceReport\n"))
+	assert.ErrorContains(t, err, `unsupported apiVersion "gateway.networking.k8s.io/v1"`)
+}
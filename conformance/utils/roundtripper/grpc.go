@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// GRPCEchoServiceName is the fully qualified name of the gRPC echo
+	// service of the echo-basic backend. It must be kept in sync with
+	// conformance/echo-basic/grpc.go.
+	GRPCEchoServiceName = "gateway_api_conformance.echo_basic.grpcecho.GrpcEcho"
+
+	// GRPCEchoStreamMethod is the server streaming method of the gRPC echo
+	// service.
+	GRPCEchoStreamMethod = "EchoStream"
+)
+
+// GRPCRoundTripper is an interface used to make gRPC calls within conformance
+// tests. This can be overridden with custom implementations whenever
+// necessary.
+type GRPCRoundTripper interface {
+	CaptureGRPCRoundTrip(GRPCRequest) (*CapturedGRPCRequest, *CapturedGRPCResponse, error)
+}
+
+// GRPCRequest is the primary input for making a gRPC call.
+type GRPCRequest struct {
+	// Address is the host and port to connect to.
+	Address string
+	// Authority overrides the :authority of the call, Address is used when
+	// it is empty.
+	Authority string
+	// Service is the fully qualified name of the service to call, it
+	// defaults to GRPCEchoServiceName.
+	Service string
+	// Method is the name of the method to call.
+	Method   string
+	Metadata map[string][]string
+	// StreamCount is the number of messages requested from a server
+	// streaming method. When it is set, Method is called as a server
+	// streaming method.
+	StreamCount int
+}
+
+// FullMethod returns the path of the method called by the request.
+func (r GRPCRequest) FullMethod() string {
+	service := r.Service
+	if service == "" {
+		service = GRPCEchoServiceName
+	}
+	return fmt.Sprintf("/%s/%s", service, r.Method)
+}
+
+// CapturedGRPCRequest contains call metadata captured from a gRPC echo
+// service response.
+type CapturedGRPCRequest struct {
+	FullMethod string
+	Authority  string
+	Headers    map[string][]string
+
+	Namespace string
+	Pod       string
+}
+
+// CapturedGRPCResponse contains response metadata.
+type CapturedGRPCResponse struct {
+	Code    codes.Code
+	Message string
+	Headers map[string][]string
+	// Messages is the number of messages received.
+	Messages int
+}
+
+// CaptureGRPCRoundTrip makes a gRPC call with the provided parameters and
+// returns the captured call and response from the echo service. An error
+// will be returned if there is an error running the function but not if a
+// non-OK gRPC status is received, in which case the captured call is nil.
+func (d *DefaultRoundTripper) CaptureGRPCRoundTrip(request GRPCRequest) (*CapturedGRPCRequest, *CapturedGRPCResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.TimeoutConfig.RequestTimeout)
+	defer cancel()
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if request.Authority != "" {
+		opts = append(opts, grpc.WithAuthority(request.Authority))
+	}
+	if d.CustomDialContext != nil {
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return d.CustomDialContext(ctx, "tcp", addr)
+		}))
+	}
+	// Like for HTTP, a new connection is used for each call so that
+	// connections are not leaked.
+	conn, err := grpc.DialContext(ctx, request.Address, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+
+	md := metadata.MD{}
+	for name, values := range request.Metadata {
+		md.Append(name, values...)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	if d.Debug {
+		fmt.Printf("Sending gRPC Request:\n< %s %s\n< %v\n\n", request.Address, request.FullMethod(), md)
+	}
+
+	var (
+		header   metadata.MD
+		messages []*structpb.Struct
+	)
+	if request.StreamCount > 0 {
+		messages, header, err = grpcServerStream(ctx, conn, request)
+	} else {
+		out := &structpb.Struct{}
+		err = conn.Invoke(ctx, request.FullMethod(), &structpb.Struct{}, out, grpc.Header(&header))
+		if err == nil {
+			messages = append(messages, out)
+		}
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, nil, err
+	}
+	cRes := &CapturedGRPCResponse{
+		Code:     st.Code(),
+		Message:  st.Message(),
+		Headers:  header,
+		Messages: len(messages),
+	}
+
+	if d.Debug {
+		fmt.Printf("Received gRPC Response:\n< %s %s\n< %v\n", cRes.Code, cRes.Message, cRes.Headers)
+		for _, msg := range messages {
+			fmt.Printf("< %v\n", msg.AsMap())
+		}
+		fmt.Println()
+	}
+
+	if cRes.Code != codes.OK || len(messages) == 0 {
+		return nil, cRes, nil
+	}
+	return capturedGRPCRequest(messages[0]), cRes, nil
+}
+
+func grpcServerStream(ctx context.Context, conn *grpc.ClientConn, request GRPCRequest) ([]*structpb.Struct, metadata.MD, error) {
+	desc := &grpc.StreamDesc{StreamName: request.Method, ServerStreams: true}
+	stream, err := conn.NewStream(ctx, desc, request.FullMethod())
+	if err != nil {
+		return nil, nil, err
+	}
+	in := &structpb.Struct{Fields: map[string]*structpb.Value{
+		"count": structpb.NewNumberValue(float64(request.StreamCount)),
+	}}
+	if err := stream.SendMsg(in); err != nil {
+		return nil, nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, nil, err
+	}
+
+	var messages []*structpb.Struct
+	for {
+		out := &structpb.Struct{}
+		err := stream.RecvMsg(out)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		messages = append(messages, out)
+	}
+	header, err := stream.Header()
+	return messages, header, err
+}
+
+func capturedGRPCRequest(msg *structpb.Struct) *CapturedGRPCRequest {
+	fields := msg.GetFields()
+	cReq := &CapturedGRPCRequest{
+		FullMethod: fields["method"].GetStringValue(),
+		Authority:  fields["authority"].GetStringValue(),
+		Namespace:  fields["namespace"].GetStringValue(),
+		Pod:        fields["pod"].GetStringValue(),
+		Headers:    map[string][]string{},
+	}
+	for name, values := range fields["headers"].GetStructValue().GetFields() {
+		for _, v := range values.GetListValue().GetValues() {
+			cReq.Headers[name] = append(cReq.Headers[name], v.GetStringValue())
+		}
+	}
+	return cReq
+}
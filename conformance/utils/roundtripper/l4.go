@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// L4RoundTripper is an interface used to send TCP and UDP payloads within
+// conformance tests. This can be overridden with custom implementations
+// whenever necessary.
+type L4RoundTripper interface {
+	CaptureL4RoundTrip(L4Request) (*CapturedL4Response, error)
+}
+
+// L4Request is the primary input for sending a payload to the TCP or UDP
+// echo listeners of the echo-basic backend.
+type L4Request struct {
+	// Protocol is either "TCP" or "UDP".
+	Protocol string
+	// Address is the host and port to dial.
+	Address string
+	// Payload is sent as a single line. It must not contain newlines.
+	Payload string
+}
+
+// CapturedL4Response contains the payload echoed by the backend along with
+// information about the backend.
+type CapturedL4Response struct {
+	Protocol string `json:"protocol"`
+	Payload  string `json:"payload"`
+
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+}
+
+// CaptureL4RoundTrip dials the address with the protocol of the request,
+// sends its payload and returns the response of the echo listener. Any
+// failure to connect, send or receive is returned as an error, UDP payloads
+// are not retried.
+func (d *DefaultRoundTripper) CaptureL4RoundTrip(request L4Request) (*CapturedL4Response, error) {
+	if strings.ContainsAny(request.Payload, "\r\n") {
+		return nil, fmt.Errorf("payload %q must not contain newlines", request.Payload)
+	}
+
+	var network string
+	switch strings.ToUpper(request.Protocol) {
+	case "TCP":
+		network = "tcp"
+	case "UDP":
+		network = "udp"
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q", request.Protocol)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.TimeoutConfig.RequestTimeout)
+	defer cancel()
+
+	dialContext := (&net.Dialer{}).DialContext
+	if d.CustomDialContext != nil {
+		dialContext = d.CustomDialContext
+	}
+	conn, err := dialContext(ctx, network, request.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	if d.Debug {
+		fmt.Printf("Sending %s payload:\n< %s %q\n\n", network, request.Address, request.Payload)
+	}
+
+	if _, err := conn.Write([]byte(request.Payload + "\n")); err != nil {
+		return nil, err
+	}
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Debug {
+		fmt.Printf("Received %s response:\n< %s\n", network, strings.TrimSpace(string(line)))
+	}
+
+	cRes := &CapturedL4Response{}
+	if err := json.Unmarshal(line, cRes); err != nil {
+		return nil, fmt.Errorf("unexpected response %q: %w", line, err)
+	}
+	return cRes, nil
+}
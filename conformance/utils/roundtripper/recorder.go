@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"sync"
+	"time"
+)
+
+// RoundTrip is a request made by a RecordingRoundTripper, along with what it
+// captured. Only the fields of the kind of the request, HTTP, gRPC or L4, are
+// set.
+type RoundTrip struct {
+	Time time.Time `json:"time"`
+
+	Request          *Request          `json:"request,omitempty"`
+	CapturedRequest  *CapturedRequest  `json:"capturedRequest,omitempty"`
+	CapturedResponse *CapturedResponse `json:"capturedResponse,omitempty"`
+
+	GRPCRequest          *GRPCRequest          `json:"grpcRequest,omitempty"`
+	CapturedGRPCRequest  *CapturedGRPCRequest  `json:"capturedGRPCRequest,omitempty"`
+	CapturedGRPCResponse *CapturedGRPCResponse `json:"capturedGRPCResponse,omitempty"`
+
+	L4Request          *L4Request          `json:"l4Request,omitempty"`
+	CapturedL4Response *CapturedL4Response `json:"capturedL4Response,omitempty"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// RecordingRoundTripper is a RoundTripper, GRPCRoundTripper and
+// L4RoundTripper keeping the last round trips made through it, to help
+// diagnose failed tests.
+type RecordingRoundTripper struct {
+	roundTripper     RoundTripper
+	grpcRoundTripper GRPCRoundTripper
+	l4RoundTripper   L4RoundTripper
+	size             int
+
+	lock sync.Mutex
+	// roundTrips is a ring buffer of at most size round trips, next is the
+	// index the next round trip is recorded at.
+	roundTrips []RoundTrip
+	next       int
+}
+
+// NewRecordingRoundTripper returns a RecordingRoundTripper making requests
+// with the given round trippers and keeping the last size round trips.
+func NewRecordingRoundTripper(roundTripper RoundTripper, grpcRoundTripper GRPCRoundTripper, l4RoundTripper L4RoundTripper, size int) *RecordingRoundTripper {
+	if size < 1 {
+		size = 1
+	}
+	return &RecordingRoundTripper{
+		roundTripper:     roundTripper,
+		grpcRoundTripper: grpcRoundTripper,
+		l4RoundTripper:   l4RoundTripper,
+		size:             size,
+	}
+}
+
+// CaptureRoundTrip makes the request with the wrapped RoundTripper and
+// records it.
+func (r *RecordingRoundTripper) CaptureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	cReq, cRes, err := r.roundTripper.CaptureRoundTrip(request)
+
+	// The certificate and the key are not needed to diagnose a failure.
+	request.CertPem = nil
+	request.KeyPem = nil
+	r.record(RoundTrip{
+		Request:          &request,
+		CapturedRequest:  cReq,
+		CapturedResponse: cRes,
+	}, err)
+
+	return cReq, cRes, err
+}
+
+// CaptureGRPCRoundTrip makes the request with the wrapped GRPCRoundTripper
+// and records it.
+func (r *RecordingRoundTripper) CaptureGRPCRoundTrip(request GRPCRequest) (*CapturedGRPCRequest, *CapturedGRPCResponse, error) {
+	cReq, cRes, err := r.grpcRoundTripper.CaptureGRPCRoundTrip(request)
+
+	r.record(RoundTrip{
+		GRPCRequest:          &request,
+		CapturedGRPCRequest:  cReq,
+		CapturedGRPCResponse: cRes,
+	}, err)
+
+	return cReq, cRes, err
+}
+
+// CaptureL4RoundTrip makes the request with the wrapped L4RoundTripper and
+// records it.
+func (r *RecordingRoundTripper) CaptureL4RoundTrip(request L4Request) (*CapturedL4Response, error) {
+	cRes, err := r.l4RoundTripper.CaptureL4RoundTrip(request)
+
+	r.record(RoundTrip{
+		L4Request:          &request,
+		CapturedL4Response: cRes,
+	}, err)
+
+	return cRes, err
+}
+
+// record adds the round trip to the ring buffer, replacing the oldest one
+// when it is full.
+func (r *RecordingRoundTripper) record(roundTrip RoundTrip, err error) {
+	roundTrip.Time = time.Now()
+	if err != nil {
+		roundTrip.Error = err.Error()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if len(r.roundTrips) < r.size {
+		r.roundTrips = append(r.roundTrips, roundTrip)
+	} else {
+		r.roundTrips[r.next] = roundTrip
+	}
+	r.next = (r.next + 1) % r.size
+}
+
+// RoundTrips returns the recorded round trips, oldest first.
+func (r *RecordingRoundTripper) RoundTrips() []RoundTrip {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(r.roundTrips) < r.size {
+		return append([]RoundTrip(nil), r.roundTrips...)
+	}
+	roundTrips := make([]RoundTrip, 0, r.size)
+	roundTrips = append(roundTrips, r.roundTrips[r.next:]...)
+	return append(roundTrips, r.roundTrips[:r.next]...)
+}
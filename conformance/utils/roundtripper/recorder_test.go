@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+type fakeRoundTripper struct{}
+
+func (fakeRoundTripper) CaptureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	if request.URL.Path == "/error" {
+		return nil, nil, errors.New("connection refused")
+	}
+	return &CapturedRequest{Path: request.URL.Path}, &CapturedResponse{StatusCode: 200}, nil
+}
+
+func (fakeRoundTripper) CaptureGRPCRoundTrip(request GRPCRequest) (*CapturedGRPCRequest, *CapturedGRPCResponse, error) {
+	return &CapturedGRPCRequest{FullMethod: request.FullMethod()}, &CapturedGRPCResponse{}, nil
+}
+
+func (fakeRoundTripper) CaptureL4RoundTrip(request L4Request) (*CapturedL4Response, error) {
+	return &CapturedL4Response{Protocol: request.Protocol, Payload: request.Payload}, nil
+}
+
+func TestRecordingRoundTripper(t *testing.T) {
+	recorder := NewRecordingRoundTripper(fakeRoundTripper{}, fakeRoundTripper{}, fakeRoundTripper{}, 3)
+	if roundTrips := recorder.RoundTrips(); len(roundTrips) != 0 {
+		t.Fatalf("Expected no round trips, got: %v", roundTrips)
+	}
+
+	for _, path := range []string{"/1", "/error", "/3", "/4", "/5"} {
+		cReq, _, err := recorder.CaptureRoundTrip(Request{
+			URL:     url.URL{Scheme: "http", Host: "gateway", Path: path},
+			CertPem: []byte("cert"),
+			KeyPem:  []byte("key"),
+		})
+		if path == "/error" {
+			if err == nil {
+				t.Errorf("Expected the error of the wrapped round tripper for %s", path)
+			}
+			continue
+		}
+		if err != nil || cReq.Path != path {
+			t.Errorf("Expected the captured request of the wrapped round tripper for %s, got: %v, %v", path, cReq, err)
+		}
+	}
+
+	var paths, errs []string
+	for _, roundTrip := range recorder.RoundTrips() {
+		paths = append(paths, roundTrip.Request.URL.Path)
+		errs = append(errs, roundTrip.Error)
+		if roundTrip.Request.CertPem != nil || roundTrip.Request.KeyPem != nil {
+			t.Errorf("Expected the certificate and key of %s not to be recorded", roundTrip.Request.URL.Path)
+		}
+	}
+	if expected := []string{"/3", "/4", "/5"}; !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Unexpected round trips, expected: %v, got: %v", expected, paths)
+	}
+	if expected := []string{"", "", ""}; !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Unexpected errors, expected: %v, got: %v", expected, errs)
+	}
+
+	recorder = NewRecordingRoundTripper(fakeRoundTripper{}, fakeRoundTripper{}, fakeRoundTripper{}, 3)
+	recorder.CaptureRoundTrip(Request{URL: url.URL{Path: "/error"}}) //nolint:errcheck
+	roundTrips := recorder.RoundTrips()
+	if len(roundTrips) != 1 || roundTrips[0].Error != "connection refused" || roundTrips[0].CapturedResponse != nil {
+		t.Errorf("Expected the failed round trip to be recorded with its error, got: %+v", roundTrips)
+	}
+}
+
+func TestRecordingRoundTripperProtocols(t *testing.T) {
+	recorder := NewRecordingRoundTripper(fakeRoundTripper{}, fakeRoundTripper{}, fakeRoundTripper{}, 3)
+
+	if _, _, err := recorder.CaptureRoundTrip(Request{URL: url.URL{Path: "/http"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := recorder.CaptureGRPCRoundTrip(GRPCRequest{Method: "Echo"}); err != nil {
+		t.Fatal(err)
+	}
+	if cRes, err := recorder.CaptureL4RoundTrip(L4Request{Protocol: "TCP", Payload: "ping"}); err != nil || cRes.Payload != "ping" {
+		t.Fatalf("Expected the response of the wrapped L4RoundTripper, got: %v, %v", cRes, err)
+	}
+
+	roundTrips := recorder.RoundTrips()
+	if len(roundTrips) != 3 {
+		t.Fatalf("Expected 3 round trips, got: %+v", roundTrips)
+	}
+	if roundTrips[0].Request == nil || roundTrips[0].GRPCRequest != nil || roundTrips[0].L4Request != nil {
+		t.Errorf("Expected an HTTP round trip, got: %+v", roundTrips[0])
+	}
+	if roundTrips[1].GRPCRequest == nil || roundTrips[1].CapturedGRPCRequest.FullMethod != "/"+GRPCEchoServiceName+"/Echo" {
+		t.Errorf("Expected a gRPC round trip, got: %+v", roundTrips[1])
+	}
+	if roundTrips[2].L4Request == nil || roundTrips[2].CapturedL4Response.Protocol != "TCP" {
+		t.Errorf("Expected an L4 round trip, got: %+v", roundTrips[2])
+	}
+}
@@ -83,6 +83,19 @@ type CapturedRequest struct {
 
 	Namespace string `json:"namespace"`
 	Pod       string `json:"pod"`
+
+	// TLS describes the TLS connection the request reached the backend
+	// with, it is nil when the backend was reached over plain text.
+	TLS *CapturedTLS `json:"tls,omitempty"`
+}
+
+// CapturedTLS contains TLS connection metadata captured from an echoserver
+// response.
+type CapturedTLS struct {
+	Version            string `json:"version"`
+	ServerName         string `json:"serverName"`
+	NegotiatedProtocol string `json:"negotiatedProtocol,omitempty"`
+	CipherSuite        string `json:"cipherSuite"`
 }
 
 // RedirectRequest contains a follow up request metadata captured from a redirect
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+const (
+	// defaultDiagnosticsRoundTrips is the default number of the last round
+	// trips of a failed test included in its diagnostics bundle.
+	defaultDiagnosticsRoundTrips = 10
+
+	diagnosticsResourcesFile  = "resources.yaml"
+	diagnosticsEventsFile     = "events.yaml"
+	diagnosticsRoundTripsFile = "roundtrips.yaml"
+	diagnosticsLogsDir        = "logs"
+)
+
+// defaultDiagnosticsDir returns the directory the diagnostics bundles are
+// written to when none is configured.
+func defaultDiagnosticsDir() string {
+	return filepath.Join(os.TempDir(), "gateway-conformance-diagnostics")
+}
+
+// collectDiagnostics writes the diagnostics bundle of a failed test to its own
+// directory in DiagnosticsDir: the Gateway API resources and the Events of the
+// test namespaces, the logs of the echo pods and the last round trips of the
+// test. Errors collecting any part of the bundle are logged and don't stop
+// the collection of the other parts.
+func (suite *ConformanceTestSuite) collectDiagnostics(t *testing.T, test *ConformanceTest, recorder *roundtripper.RecordingRoundTripper) {
+	t.Helper()
+
+	dir := filepath.Join(suite.DiagnosticsDir, test.ShortName)
+	if err := os.RemoveAll(dir); err != nil {
+		t.Logf("Error removing previous diagnostics bundle %s: %v", dir, err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Join(dir, diagnosticsLogsDir), 0o750); err != nil {
+		t.Logf("Error creating diagnostics bundle %s: %v", dir, err)
+		return
+	}
+
+	namespaces := suite.diagnosticsNamespaces()
+	writeFile := func(name string, data []byte) {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+			t.Logf("Error writing diagnostics %s: %v", name, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.GetTimeout)
+	resources, err := kubernetes.DumpResources(ctx, suite.Client, namespaces)
+	cancel()
+	if err != nil {
+		t.Logf("Error collecting resources for diagnostics: %v", err)
+	} else {
+		writeFile(diagnosticsResourcesFile, resources)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), suite.TimeoutConfig.GetTimeout)
+	events, err := kubernetes.DumpEvents(ctx, suite.Client, namespaces)
+	cancel()
+	if err != nil {
+		t.Logf("Error collecting events for diagnostics: %v", err)
+	} else {
+		writeFile(diagnosticsEventsFile, events)
+	}
+
+	if suite.Clientset != nil {
+		for _, ns := range namespaces {
+			for _, app := range suite.echoApps(ns) {
+				logs, err := kubernetes.DumpEchoLogs(ns, app, suite.Client, suite.Clientset)
+				if err != nil {
+					t.Logf("Error collecting logs of %s/%s for diagnostics: %v", ns, app, err)
+					continue
+				}
+				for i, log := range logs {
+					writeFile(filepath.Join(diagnosticsLogsDir, fmt.Sprintf("%s_%s_%d.log", ns, app, i)), log)
+				}
+			}
+		}
+	}
+
+	if recorder != nil {
+		roundTrips, err := yaml.Marshal(recorder.RoundTrips())
+		if err != nil {
+			t.Logf("Error marshaling round trips for diagnostics: %v", err)
+		} else {
+			writeFile(diagnosticsRoundTripsFile, roundTrips)
+		}
+	}
+
+	t.Logf("Diagnostics bundle written to %s", dir)
+}
+
+// diagnosticsNamespaces returns the namespaces the resources of the tests are
+// applied to, given the features of the suite.
+func (suite *ConformanceTestSuite) diagnosticsNamespaces() []string {
+	var namespaces []string
+	seen := sets.New[string]()
+	add := func(names ...string) {
+		for _, name := range names {
+			ns := suite.Namespace(name)
+			if !seen.Has(ns) {
+				seen.Insert(ns)
+				namespaces = append(namespaces, ns)
+			}
+		}
+	}
+	if suite.SupportedFeatures.Has(SupportGateway) {
+		add("gateway-conformance-infra", "gateway-conformance-app-backend", "gateway-conformance-web-backend")
+	}
+	if suite.SupportedFeatures.Has(SupportMesh) {
+		add("gateway-conformance-mesh", "gateway-conformance-mesh-consumer", "gateway-conformance-app-backend", "gateway-conformance-web-backend")
+	}
+	return namespaces
+}
+
+// echoApps returns the values of the app label of the pods in the namespace,
+// which name the echo containers whose logs are collected.
+func (suite *ConformanceTestSuite) echoApps(ns string) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.GetTimeout)
+	defer cancel()
+
+	pods := &corev1.PodList{}
+	if err := suite.Client.List(ctx, pods, client.InNamespace(ns)); err != nil {
+		return nil
+	}
+	apps := sets.New[string]()
+	for _, pod := range pods.Items {
+		if app := pod.Labels["app"]; app != "" {
+			apps.Insert(app)
+		}
+	}
+	return sets.List(apps)
+}
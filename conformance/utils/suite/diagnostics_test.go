@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+type statusRoundTripper struct {
+	statusCode int
+}
+
+func (r statusRoundTripper) CaptureRoundTrip(roundtripper.Request) (*roundtripper.CapturedRequest, *roundtripper.CapturedResponse, error) {
+	return nil, &roundtripper.CapturedResponse{StatusCode: r.statusCode}, nil
+}
+
+func TestCollectDiagnostics(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "gateway-conformance-infra-httprouteheadermatching", Name: "same-namespace"}},
+		&v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "gateway-conformance-infra", Name: "shared"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Namespace: "gateway-conformance-app-backend", Name: "event"}, Reason: "BackOff"},
+	).Build()
+
+	suite := &ConformanceTestSuite{
+		Client:            c,
+		SupportedFeatures: sets.New(SupportGateway),
+		DiagnosticsDir:    t.TempDir(),
+	}
+	suite.Applier.NamespaceMapping = map[string]string{
+		"gateway-conformance-infra": "gateway-conformance-infra-httprouteheadermatching",
+	}
+
+	recorder := roundtripper.NewRecordingRoundTripper(statusRoundTripper{statusCode: 503}, nil, nil, 2)
+	for _, path := range []string{"/1", "/2", "/3"} {
+		if _, _, err := recorder.CaptureRoundTrip(roundtripper.Request{URL: url.URL{Path: path}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	test := &ConformanceTest{ShortName: "HTTPRouteHeaderMatching"}
+	suite.collectDiagnostics(t, test, recorder)
+
+	dir := filepath.Join(suite.DiagnosticsDir, test.ShortName)
+	readFile := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("Expected the diagnostics bundle to contain %s: %v", name, err)
+		}
+		return string(data)
+	}
+
+	resources := readFile(diagnosticsResourcesFile)
+	if !strings.Contains(resources, "name: same-namespace") {
+		t.Errorf("Expected the Gateway of the isolated namespace to be dumped, got: %s", resources)
+	}
+	if strings.Contains(resources, "name: shared") {
+		t.Errorf("Expected the Gateway of the mapped namespace not to be dumped, got: %s", resources)
+	}
+	if events := readFile(diagnosticsEventsFile); !strings.Contains(events, "reason: BackOff") {
+		t.Errorf("Expected the events of the test namespaces to be dumped, got: %s", events)
+	}
+	roundTrips := readFile(diagnosticsRoundTripsFile)
+	if strings.Contains(roundTrips, "/1") || !strings.Contains(roundTrips, "/2") || !strings.Contains(roundTrips, "/3") {
+		t.Errorf("Expected the last 2 round trips to be dumped, got: %s", roundTrips)
+	}
+	if !strings.Contains(roundTrips, "StatusCode: 503") {
+		t.Errorf("Expected the captured responses to be dumped, got: %s", roundTrips)
+	}
+}
+
+func TestDiagnosticsNamespaces(t *testing.T) {
+	suite := &ConformanceTestSuite{SupportedFeatures: sets.New(SupportGateway, SupportMesh)}
+	expected := []string{
+		"gateway-conformance-infra",
+		"gateway-conformance-app-backend",
+		"gateway-conformance-web-backend",
+		"gateway-conformance-mesh",
+		"gateway-conformance-mesh-consumer",
+	}
+	if got := suite.diagnosticsNamespaces(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Unexpected namespaces, expected: %v, got: %v", expected, got)
+	}
+}
@@ -48,6 +48,18 @@ const (
 	// which covers TLS stream functionality, such as the TLSRoute API.
 	TLSConformanceProfileName ConformanceProfileName = "TLS"
 
+	// TCPConformanceProfileName indicates the name of the conformance profile
+	// which covers TCP stream functionality, such as the TCPRoute API.
+	TCPConformanceProfileName ConformanceProfileName = "TCP"
+
+	// UDPConformanceProfileName indicates the name of the conformance profile
+	// which covers UDP datagram functionality, such as the UDPRoute API.
+	UDPConformanceProfileName ConformanceProfileName = "UDP"
+
+	// GRPCConformanceProfileName indicates the name of the conformance profile
+	// which covers gRPC functionality, such as the GRPCRoute API.
+	GRPCConformanceProfileName ConformanceProfileName = "GRPC"
+
 	// MeshConformanceProfileName indicates the name of the conformance profile
 	// which covers service mesh functionality.
 	MeshConformanceProfileName ConformanceProfileName = "MESH"
@@ -81,6 +93,40 @@ var (
 		),
 	}
 
+	// TCPConformanceProfile is a ConformanceProfile that covers testing TCP
+	// related functionality with Gateways.
+	TCPConformanceProfile = ConformanceProfile{
+		Name: TCPConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportTCPRoute,
+		),
+	}
+
+	// UDPConformanceProfile is a ConformanceProfile that covers testing UDP
+	// related functionality with Gateways.
+	UDPConformanceProfile = ConformanceProfile{
+		Name: UDPConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportUDPRoute,
+		),
+	}
+
+	// GRPCConformanceProfile is a ConformanceProfile that covers testing gRPC
+	// related functionality with Gateways.
+	GRPCConformanceProfile = ConformanceProfile{
+		Name: GRPCConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportGRPCRoute,
+		),
+		ExtendedFeatures: GRPCRouteExtendedFeatures,
+	}
+
 	// MeshConformanceProfile is a ConformanceProfile that covers testing
 	// service mesh related functionality.
 	MeshConformanceProfile = ConformanceProfile{
@@ -96,7 +142,9 @@ var (
 // -----------------------------------------------------------------------------
 
 // conformanceProfileMap maps short human-readable names to their respective
-// ConformanceProfiles.
+// ConformanceProfiles. The GRPC, TCP and UDP profiles are left out until the
+// echo-basic image referenced by the base manifests has the listeners their
+// tests need, see EchoListenerFeatures.
 var conformanceProfileMap = map[ConformanceProfileName]ConformanceProfile{
 	HTTPConformanceProfileName: HTTPConformanceProfile,
 	TLSConformanceProfileName:  TLSConformanceProfile,
@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	confv1a2 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha2"
+)
+
+// githubHandleRegexp matches Github usernames (@<username>) and team names
+// (@<team>/<name>).
+var githubHandleRegexp = regexp.MustCompile(`^@[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?(?:/[A-Za-z0-9._-]+)?$`)
+
+// renamedFeatures are the former names of features, still accepted in the
+// reports submitted for older releases.
+var renamedFeatures = sets.New(
+	// renamed to HTTPRouteResponseHeaderModification in v1.0.0
+	"HTTPResponseHeaderModification",
+)
+
+// ValidateConformanceReport validates a conformance report submitted by an
+// implementation.
+func ValidateConformanceReport(report *confv1a2.ConformanceReport) field.ErrorList {
+	var errs field.ErrorList
+
+	if report.Date == "" {
+		errs = append(errs, field.Required(field.NewPath("date"), ""))
+	} else if _, err := time.Parse(time.RFC3339, report.Date); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("date"), report.Date, "must be an RFC 3339 date"))
+	}
+	if report.GatewayAPIVersion == "" {
+		errs = append(errs, field.Required(field.NewPath("gatewayAPIVersion"), ""))
+	}
+	errs = append(errs, ValidateImplementation(&report.Implementation, field.NewPath("implementation"))...)
+
+	if report.Mode != nil {
+		path := field.NewPath("mode")
+		errs = append(errs, validateFeatureNames(report.Mode.SupportedFeatures, path.Child("supportedFeatures"))...)
+		errs = append(errs, validateFeatureNames(report.Mode.ExemptFeatures, path.Child("exemptFeatures"))...)
+	}
+
+	path := field.NewPath("profiles")
+	if len(report.ProfileReports) == 0 {
+		errs = append(errs, field.Required(path, "at least one profile must be reported"))
+	}
+	profileNames := sets.New[string]()
+	for i, profile := range report.ProfileReports {
+		errs = append(errs, validateProfileReport(profile, profileNames, path.Index(i))...)
+	}
+
+	return errs
+}
+
+// ValidateImplementation validates the details of the implementation which
+// ran the conformance tests.
+func ValidateImplementation(implementation *confv1a2.Implementation, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if implementation.Organization == "" {
+		errs = append(errs, field.Required(path.Child("organization"), ""))
+	}
+	if implementation.Project == "" {
+		errs = append(errs, field.Required(path.Child("project"), ""))
+	}
+	if implementation.Version == "" {
+		errs = append(errs, field.Required(path.Child("version"), ""))
+	}
+	if implementation.URL == "" {
+		errs = append(errs, field.Required(path.Child("url"), ""))
+	} else if !isHTTPURL(implementation.URL) {
+		errs = append(errs, field.Invalid(path.Child("url"), implementation.URL, "must be an http or https URL"))
+	}
+
+	if len(implementation.Contact) == 0 {
+		errs = append(errs, field.Required(path.Child("contact"), ""))
+	}
+	for i, contact := range implementation.Contact {
+		if !isValidContact(contact) {
+			errs = append(errs, field.Invalid(path.Child("contact").Index(i), contact, "must be a Github username (@<username>), a Github team (@<team>/<name>), an email address or an http or https URL"))
+		}
+	}
+
+	return errs
+}
+
+func validateProfileReport(profile confv1a2.ProfileReport, profileNames sets.Set[string], path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if _, ok := conformanceProfileMap[ConformanceProfileName(profile.Name)]; !ok {
+		errs = append(errs, field.NotSupported(path.Child("name"), profile.Name, sortedProfileNames()))
+	} else if profileNames.Has(profile.Name) {
+		errs = append(errs, field.Duplicate(path.Child("name"), profile.Name))
+	}
+	profileNames.Insert(profile.Name)
+
+	errs = append(errs, validateStatus(profile.Core, path.Child("core"))...)
+	if profile.Extended != nil {
+		extendedPath := path.Child("extended")
+		errs = append(errs, validateStatus(profile.Extended.Status, extendedPath)...)
+		errs = append(errs, validateFeatureNames(profile.Extended.SupportedFeatures, extendedPath.Child("supportedFeatures"))...)
+		errs = append(errs, validateFeatureNames(profile.Extended.UnsupportedFeatures, extendedPath.Child("unsupportedFeatures"))...)
+	}
+
+	return errs
+}
+
+// validateStatus checks that the statistics match the tests listed and that
+// the result agrees with the statistics.
+func validateStatus(status confv1a2.Status, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	statsPath := path.Child("statistics")
+	if int(status.Failed) != len(status.FailedTests) {
+		errs = append(errs, field.Invalid(statsPath.Child("Failed"), int(status.Failed), fmt.Sprintf("must match the %d failed tests listed", len(status.FailedTests))))
+	}
+	if int(status.Skipped) != len(status.SkippedTests) {
+		errs = append(errs, field.Invalid(statsPath.Child("Skipped"), int(status.Skipped), fmt.Sprintf("must match the %d skipped tests listed", len(status.SkippedTests))))
+	}
+
+	// v1alpha1 reports don't list passed tests, the number of passed tests
+	// is only checked when some are listed.
+	counts := map[confv1a2.TestResultType]int{}
+	for _, test := range status.Tests {
+		counts[test.Result]++
+	}
+	if counts[confv1a2.TestPassed] > 0 && int(status.Passed) != counts[confv1a2.TestPassed] {
+		errs = append(errs, field.Invalid(statsPath.Child("Passed"), int(status.Passed), fmt.Sprintf("must match the %d passed tests listed", counts[confv1a2.TestPassed])))
+	}
+
+	var expected confv1a2.Result
+	switch {
+	case status.Failed > 0:
+		expected = confv1a2.Failure
+	case status.Skipped > 0:
+		expected = confv1a2.Partial
+	default:
+		expected = confv1a2.Success
+	}
+	if status.Result != expected {
+		errs = append(errs, field.Invalid(path.Child("result"), status.Result, fmt.Sprintf("must be %s given the statistics", expected)))
+	}
+
+	return errs
+}
+
+func validateFeatureNames(features []string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, feature := range features {
+		if !KnownFeatures.Has(SupportedFeature(feature)) && !renamedFeatures.Has(feature) {
+			errs = append(errs, field.Invalid(path.Index(i), feature, "unknown feature"))
+		}
+	}
+	return errs
+}
+
+func sortedProfileNames() []string {
+	names := make([]string, 0, len(conformanceProfileMap))
+	for name := range conformanceProfileMap {
+		names = append(names, string(name))
+	}
+	return sets.List(sets.New(names...))
+}
+
+// isHTTPURL returns whether the value is an http or https URL. The scheme can
+// be omitted, as in github.com/<organization>/<project>.
+func isHTTPURL(value string) bool {
+	if !strings.Contains(value, "://") {
+		value = "https://" + value
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && strings.Contains(u.Hostname(), ".")
+}
+
+func isValidContact(contact string) bool {
+	if githubHandleRegexp.MatchString(contact) || isHTTPURL(contact) {
+		return true
+	}
+	address, err := mail.ParseAddress(contact)
+	return err == nil && address.Address == contact
+}
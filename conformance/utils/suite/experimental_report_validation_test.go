@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"reflect"
+	"testing"
+
+	confv1a2 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha2"
+)
+
+func validReport() *confv1a2.ConformanceReport {
+	return &confv1a2.ConformanceReport{
+		Implementation: confv1a2.Implementation{
+			Organization: "example",
+			Project:      "gateway",
+			URL:          "https://example.com/gateway",
+			Version:      "v1.0.0",
+			Contact:      []string{"@example", "@example/maintainers", "maintainers@example.com", "https://example.com/support"},
+		},
+		Date:              "2023-11-01T10:00:00Z",
+		GatewayAPIVersion: "v1.0.0",
+		ProfileReports: []confv1a2.ProfileReport{{
+			Name: "HTTP",
+			Core: confv1a2.Status{
+				Result:      confv1a2.Failure,
+				Statistics:  confv1a2.Statistics{Passed: 1, Failed: 1},
+				FailedTests: []string{"HTTPRouteHeaderMatching"},
+				Tests: []confv1a2.TestResult{
+					{Name: "HTTPRouteSimpleSameNamespace", Result: confv1a2.TestPassed},
+					{Name: "HTTPRouteHeaderMatching", Result: confv1a2.TestFailed},
+				},
+			},
+			Extended: &confv1a2.ExtendedStatus{
+				Status: confv1a2.Status{
+					Result:       confv1a2.Partial,
+					Statistics:   confv1a2.Statistics{Skipped: 1},
+					SkippedTests: []string{"HTTPRouteMethodMatching"},
+				},
+				SupportedFeatures: []string{"HTTPRouteMethodMatching"},
+			},
+		}},
+	}
+}
+
+func TestValidateConformanceReport(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(*confv1a2.ConformanceReport)
+		expected []string
+	}{{
+		name:   "valid report",
+		mutate: func(*confv1a2.ConformanceReport) {},
+	}, {
+		name: "url and contact without scheme",
+		mutate: func(r *confv1a2.ConformanceReport) {
+			r.URL = "github.com/example/gateway"
+			r.Contact = []string{"github.com/example/gateway/issues/new/choose"}
+		},
+	}, {
+		name: "missing implementation fields",
+		mutate: func(r *confv1a2.ConformanceReport) {
+			r.Implementation = confv1a2.Implementation{}
+		},
+		expected: []string{
+			"implementation.organization: Required value",
+			"implementation.project: Required value",
+			"implementation.version: Required value",
+			"implementation.url: Required value",
+			"implementation.contact: Required value",
+		},
+	}, {
+		name: "invalid url and contact",
+		mutate: func(r *confv1a2.ConformanceReport) {
+			r.URL = "ftp://example.com/gateway"
+			r.Contact = []string{"example", "Maintainers <maintainers@example.com>"}
+		},
+		expected: []string{
+			`implementation.url: Invalid value: "ftp://example.com/gateway": must be an http or https URL`,
+			`implementation.contact[0]: Invalid value: "example": must be a Github username (@<username>), a Github team (@<team>/<name>), an email address or an http or https URL`,
+			`implementation.contact[1]: Invalid value: "Maintainers <maintainers@example.com>": must be a Github username (@<username>), a Github team (@<team>/<name>), an email address or an http or https URL`,
+		},
+	}, {
+		name: "unknown profile and features",
+		mutate: func(r *confv1a2.ConformanceReport) {
+			r.ProfileReports[0].Name = "HTTPS"
+			r.ProfileReports[0].Extended.SupportedFeatures = []string{"HTTPRouteTeleport"}
+		},
+		expected: []string{
+			`profiles[0].name: Unsupported value: "HTTPS": supported values: "GRPC", "HTTP", "MESH", "TCP", "TLS", "UDP"`,
+			`profiles[0].extended.supportedFeatures[0]: Invalid value: "HTTPRouteTeleport": unknown feature`,
+		},
+	}, {
+		name: "duplicate profile",
+		mutate: func(r *confv1a2.ConformanceReport) {
+			r.ProfileReports = append(r.ProfileReports, r.ProfileReports[0])
+		},
+		expected: []string{
+			`profiles[1].name: Duplicate value: "HTTP"`,
+		},
+	}, {
+		name: "statistics and results mismatch",
+		mutate: func(r *confv1a2.ConformanceReport) {
+			r.ProfileReports[0].Core.Passed = 3
+			r.ProfileReports[0].Core.Failed = 0
+			r.ProfileReports[0].Extended.Result = confv1a2.Success
+		},
+		expected: []string{
+			"profiles[0].core.statistics.Failed: Invalid value: 0: must match the 1 failed tests listed",
+			"profiles[0].core.statistics.Passed: Invalid value: 3: must match the 1 passed tests listed",
+			`profiles[0].core.result: Invalid value: "failure": must be success given the statistics`,
+			`profiles[0].extended.result: Invalid value: "success": must be partial given the statistics`,
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report := validReport()
+			tc.mutate(report)
+
+			var got []string
+			for _, err := range ValidateConformanceReport(report) {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Unexpected errors, expected: %q, got: %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseImplementation(t *testing.T) {
+	implementation, err := ParseImplementation("example", "gateway", "https://example.com/gateway", "v1.0.0", "@example/maintainers, maintainers@example.com,")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"@example/maintainers", "maintainers@example.com"}
+	if !reflect.DeepEqual(implementation.Contact, expected) {
+		t.Errorf("Unexpected contacts, expected: %v, got: %v", expected, implementation.Contact)
+	}
+
+	if _, err := ParseImplementation("example", "gateway", "github.com/example/gateway", "v1.0.0", "@example"); err != nil {
+		t.Errorf("Unexpected error for a url without scheme: %v", err)
+	}
+	if _, err := ParseImplementation("example", "gateway", "ftp://example.com/gateway", "v1.0.0", "@example"); err == nil {
+		t.Errorf("Expected an error for an invalid url")
+	}
+	if _, err := ParseImplementation("example", "gateway", "https://example.com/gateway", "v1.0.0", " , "); err == nil {
+		t.Errorf("Expected an error for an empty contact")
+	}
+}
@@ -17,9 +17,10 @@ limitations under the License.
 package suite
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
-	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+	confv1a2 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha2"
 )
 
 // -----------------------------------------------------------------------------
@@ -29,6 +30,8 @@ import (
 type testResult struct {
 	test   ConformanceTest
 	result resultType
+	// record describes the run of the test, including its duration.
+	record TestRecord
 }
 
 type resultType string
@@ -40,7 +43,7 @@ var (
 	testNotSupported resultType = "NOT_SUPPORTED"
 )
 
-type profileReportsMap map[ConformanceProfileName]confv1a1.ProfileReport
+type profileReportsMap map[ConformanceProfileName]confv1a2.ProfileReport
 
 func newReports() profileReportsMap {
 	return make(profileReportsMap)
@@ -49,7 +52,7 @@ func newReports() profileReportsMap {
 func (p profileReportsMap) addTestResults(conformanceProfile ConformanceProfile, result testResult) {
 	// initialize the profile report if not already initialized
 	if _, ok := p[conformanceProfile.Name]; !ok {
-		p[conformanceProfile.Name] = confv1a1.ProfileReport{
+		p[conformanceProfile.Name] = confv1a2.ProfileReport{
 			Name: string(conformanceProfile.Name),
 		}
 	}
@@ -61,7 +64,7 @@ func (p profileReportsMap) addTestResults(conformanceProfile ConformanceProfile,
 	case testSucceeded:
 		if testIsExtended {
 			if report.Extended == nil {
-				report.Extended = &confv1a1.ExtendedStatus{}
+				report.Extended = &confv1a2.ExtendedStatus{}
 			}
 			report.Extended.Statistics.Passed++
 		} else {
@@ -70,7 +73,7 @@ func (p profileReportsMap) addTestResults(conformanceProfile ConformanceProfile,
 	case testFailed:
 		if testIsExtended {
 			if report.Extended == nil {
-				report.Extended = &confv1a1.ExtendedStatus{}
+				report.Extended = &confv1a2.ExtendedStatus{}
 			}
 			if report.Extended.FailedTests == nil {
 				report.Extended.FailedTests = []string{}
@@ -87,7 +90,7 @@ func (p profileReportsMap) addTestResults(conformanceProfile ConformanceProfile,
 	case testSkipped:
 		if testIsExtended {
 			if report.Extended == nil {
-				report.Extended = &confv1a1.ExtendedStatus{}
+				report.Extended = &confv1a2.ExtendedStatus{}
 			}
 			report.Extended.Statistics.Skipped++
 			if report.Extended.SkippedTests == nil {
@@ -102,10 +105,43 @@ func (p profileReportsMap) addTestResults(conformanceProfile ConformanceProfile,
 			report.Core.SkippedTests = append(report.Core.SkippedTests, result.test.ShortName)
 		}
 	}
+
+	if testReport, ok := result.report(); ok {
+		if testIsExtended {
+			report.Extended.Tests = append(report.Extended.Tests, testReport)
+		} else {
+			report.Core.Tests = append(report.Core.Tests, testReport)
+		}
+	}
 	p[conformanceProfile.Name] = report
 }
 
-func (p profileReportsMap) list() (profileReports []confv1a1.ProfileReport) {
+// report returns the result of the test for conformance reports. Tests whose
+// features are not supported aren't reported.
+func (r testResult) report() (confv1a2.TestResult, bool) {
+	testReport := confv1a2.TestResult{
+		Name: r.test.ShortName,
+	}
+	if !r.record.End.IsZero() {
+		testReport.Duration = &metav1.Duration{Duration: r.record.Duration()}
+	}
+
+	switch r.result {
+	case testSucceeded:
+		testReport.Result = confv1a2.TestPassed
+	case testFailed:
+		testReport.Result = confv1a2.TestFailed
+		testReport.FailureMessage = r.record.FailureMessage
+	case testSkipped:
+		testReport.Result = confv1a2.TestSkipped
+		testReport.SkipReason = r.record.SkipReason
+	default:
+		return confv1a2.TestResult{}, false
+	}
+	return testReport, true
+}
+
+func (p profileReportsMap) list() (profileReports []confv1a2.ProfileReport) {
 	for _, profileReport := range p {
 		profileReports = append(profileReports, profileReport)
 	}
@@ -117,22 +153,22 @@ func (p profileReportsMap) compileResults(supportedFeaturesMap map[ConformancePr
 		// report the overall result for core features
 		switch {
 		case report.Core.Failed > 0:
-			report.Core.Result = confv1a1.Failure
+			report.Core.Result = confv1a2.Failure
 		case report.Core.Skipped > 0:
-			report.Core.Result = confv1a1.Partial
+			report.Core.Result = confv1a2.Partial
 		default:
-			report.Core.Result = confv1a1.Success
+			report.Core.Result = confv1a2.Success
 		}
 
 		if report.Extended != nil {
 			// report the overall result for extended features
 			switch {
 			case report.Extended.Failed > 0:
-				report.Extended.Result = confv1a1.Failure
+				report.Extended.Result = confv1a2.Failure
 			case report.Extended.Skipped > 0:
-				report.Extended.Result = confv1a1.Partial
+				report.Extended.Result = confv1a2.Partial
 			default:
-				report.Extended.Result = confv1a1.Success
+				report.Extended.Result = confv1a2.Success
 			}
 		}
 		p[key] = report
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	confv1a2 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha2"
+)
+
+func TestAddTestResults(t *testing.T) {
+	start := time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+	newResult := func(name string, res resultType, features ...SupportedFeature) testResult {
+		test := ConformanceTest{ShortName: name, Features: features}
+		record := TestRecord{ShortName: name, Start: start, End: start.Add(2 * time.Second), Result: string(res)}
+		switch res {
+		case testFailed:
+			record.FailureMessage = name + " failed"
+		case testSkipped, testNotSupported:
+			record.SkipReason = "test explicitly skipped"
+		}
+		return testResult{test: test, result: res, record: record}
+	}
+
+	reports := newReports()
+	for _, result := range []testResult{
+		newResult("Core", testSucceeded, SupportGateway, SupportHTTPRoute),
+		newResult("CoreFailed", testFailed, SupportGateway, SupportHTTPRoute),
+		newResult("Extended", testSkipped, SupportGateway, SupportHTTPRoute, SupportHTTPRouteMethodMatching),
+		newResult("NotSupported", testNotSupported, SupportGateway, SupportHTTPRoute, SupportHTTPRouteQueryParamMatching),
+	} {
+		reports.addTestResults(HTTPConformanceProfile, result)
+	}
+
+	duration := &metav1.Duration{Duration: 2 * time.Second}
+	report := reports[HTTPConformanceProfileName]
+	expectedCore := []confv1a2.TestResult{
+		{Name: "Core", Result: confv1a2.TestPassed, Duration: duration},
+		{Name: "CoreFailed", Result: confv1a2.TestFailed, Duration: duration, FailureMessage: "CoreFailed failed"},
+	}
+	if !reflect.DeepEqual(report.Core.Tests, expectedCore) {
+		t.Errorf("Unexpected core test results, expected: %+v, got: %+v", expectedCore, report.Core.Tests)
+	}
+	expectedExtended := []confv1a2.TestResult{
+		{Name: "Extended", Result: confv1a2.TestSkipped, Duration: duration, SkipReason: "test explicitly skipped"},
+	}
+	if report.Extended == nil || !reflect.DeepEqual(report.Extended.Tests, expectedExtended) {
+		t.Errorf("Unexpected extended test results, expected: %+v, got: %+v", expectedExtended, report.Extended)
+	}
+}
@@ -19,6 +19,7 @@ package suite
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -26,12 +27,14 @@ import (
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"sigs.k8s.io/gateway-api/conformance"
-	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+	confv1a2 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
 	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
 	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/pkg/consts"
 )
 
 // -----------------------------------------------------------------------------
@@ -48,7 +51,11 @@ type ExperimentalConformanceTestSuite struct {
 
 	// implementation contains the details of the implementation, such as
 	// organization, project, etc.
-	implementation confv1a1.Implementation
+	implementation confv1a2.Implementation
+
+	// kubernetesVersion is the version of the Kubernetes API server, found
+	// during Setup.
+	kubernetesVersion string
 
 	// conformanceProfiles is a compiled list of profiles to check
 	// conformance against.
@@ -69,6 +76,9 @@ type ExperimentalConformanceTestSuite struct {
 	// marked as not supported, and is used for reporting the test results.
 	extendedUnsupportedFeatures map[ConformanceProfileName]sets.Set[SupportedFeature]
 
+	// testReporters are notified of the tests as they run.
+	testReporters []TestReporter
+
 	// lock is a mutex to help ensure thread safety of the test suite object.
 	lock sync.RWMutex
 }
@@ -77,8 +87,12 @@ type ExperimentalConformanceTestSuite struct {
 type ExperimentalConformanceOptions struct {
 	Options
 
-	Implementation      confv1a1.Implementation
+	Implementation      confv1a2.Implementation
 	ConformanceProfiles sets.Set[ConformanceProfileName]
+
+	// TestReporters are notified of the tests as they run, for instance to
+	// write JUnit XML reports or JSON events.
+	TestReporters []TestReporter
 }
 
 // NewExperimentalConformanceTestSuite is a helper to use for creating a new ExperimentalConformanceTestSuite.
@@ -90,19 +104,33 @@ func NewExperimentalConformanceTestSuite(s ExperimentalConformanceOptions) (*Exp
 		roundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
 	}
 
+	grpcRoundTripper := s.GRPCRoundTripper
+	if grpcRoundTripper == nil {
+		grpcRoundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
+	l4RoundTripper := s.L4RoundTripper
+	if l4RoundTripper == nil {
+		l4RoundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
 	suite := &ExperimentalConformanceTestSuite{
 		results:                     make(map[string]testResult),
 		extendedUnsupportedFeatures: make(map[ConformanceProfileName]sets.Set[SupportedFeature]),
 		extendedSupportedFeatures:   make(map[ConformanceProfileName]sets.Set[SupportedFeature]),
 		conformanceProfiles:         s.ConformanceProfiles,
 		implementation:              s.Implementation,
+		testReporters:               s.TestReporters,
 	}
 
 	// test suite callers are required to provide a conformance profile OR at
 	// minimum a list of features which they support.
-	if s.SupportedFeatures == nil && s.ConformanceProfiles.Len() == 0 && !s.EnableAllSupportedFeatures {
+	if s.SupportedFeatures == nil && s.ConformanceProfiles.Len() == 0 && !s.EnableAllSupportedFeatures && !s.SupportedFeaturesFromGatewayClass {
 		return nil, fmt.Errorf("no conformance profile was selected for test run, and no supported features were provided so no tests could be selected")
 	}
+	if s.EnableAllSupportedFeatures && s.SupportedFeaturesFromGatewayClass {
+		return nil, fmt.Errorf("all features can't be enabled when reading the supported features from the GatewayClass")
+	}
 
 	// test suite callers can potentially just run all tests by saying they
 	// cover all features, if they don't they'll need to have provided a
@@ -114,35 +142,8 @@ func NewExperimentalConformanceTestSuite(s ExperimentalConformanceOptions) (*Exp
 			s.SupportedFeatures = sets.New[SupportedFeature]()
 		}
 
-		for _, conformanceProfileName := range s.ConformanceProfiles.UnsortedList() {
-			conformanceProfile, err := getConformanceProfileForName(conformanceProfileName)
-			if err != nil {
-				return nil, fmt.Errorf("failed to retrieve conformance profile: %w", err)
-			}
-			// the use of a conformance profile implicitly enables any features of
-			// that profile which are supported at a Core level of support.
-			for _, f := range conformanceProfile.CoreFeatures.UnsortedList() {
-				if !s.SupportedFeatures.Has(f) {
-					s.SupportedFeatures.Insert(f)
-				}
-			}
-			for _, f := range conformanceProfile.ExtendedFeatures.UnsortedList() {
-				if s.SupportedFeatures.Has(f) {
-					if suite.extendedSupportedFeatures[conformanceProfileName] == nil {
-						suite.extendedSupportedFeatures[conformanceProfileName] = sets.New[SupportedFeature]()
-					}
-					suite.extendedSupportedFeatures[conformanceProfileName].Insert(f)
-				} else {
-					if suite.extendedUnsupportedFeatures[conformanceProfileName] == nil {
-						suite.extendedUnsupportedFeatures[conformanceProfileName] = sets.New[SupportedFeature]()
-					}
-					suite.extendedUnsupportedFeatures[conformanceProfileName].Insert(f)
-				}
-				// Add Exempt Features into unsupported features list
-				if s.ExemptFeatures.Has(f) {
-					suite.extendedUnsupportedFeatures[conformanceProfileName].Insert(f)
-				}
-			}
+		if err := suite.compileProfileFeatures(s.SupportedFeatures, s.ExemptFeatures); err != nil {
+			return nil, err
 		}
 	}
 
@@ -155,25 +156,33 @@ func NewExperimentalConformanceTestSuite(s ExperimentalConformanceOptions) (*Exp
 	}
 
 	suite.ConformanceTestSuite = ConformanceTestSuite{
-		Client:           s.Client,
-		Clientset:        s.Clientset,
-		RestConfig:       s.RestConfig,
-		RoundTripper:     roundTripper,
-		GatewayClassName: s.GatewayClassName,
-		Debug:            s.Debug,
-		Cleanup:          s.CleanupBaseResources,
-		BaseManifests:    s.BaseManifests,
-		MeshManifests:    s.MeshManifests,
+		Client:            s.Client,
+		Clientset:         s.Clientset,
+		RestConfig:        s.RestConfig,
+		RoundTripper:      roundTripper,
+		GRPCRoundTripper:  grpcRoundTripper,
+		L4RoundTripper:    l4RoundTripper,
+		GatewayClassName:  s.GatewayClassName,
+		Debug:             s.Debug,
+		Cleanup:           s.CleanupBaseResources,
+		BaseManifests:     s.BaseManifests,
+		MeshManifests:     s.MeshManifests,
+		ParallelManifests: s.ParallelManifests,
+		Parallelism:       s.Parallelism,
 		Applier: kubernetes.Applier{
 			NamespaceLabels:      s.NamespaceLabels,
 			NamespaceAnnotations: s.NamespaceAnnotations,
 		},
-		SupportedFeatures:        s.SupportedFeatures,
-		TimeoutConfig:            s.TimeoutConfig,
-		SkipTests:                sets.New(s.SkipTests...),
-		FS:                       *s.FS,
-		UsableNetworkAddresses:   s.UsableNetworkAddresses,
-		UnusableNetworkAddresses: s.UnusableNetworkAddresses,
+		SupportedFeatures:                 s.SupportedFeatures,
+		ExemptFeatures:                    s.ExemptFeatures,
+		SupportedFeaturesFromGatewayClass: s.SupportedFeaturesFromGatewayClass,
+		TimeoutConfig:                     s.TimeoutConfig,
+		SkipTests:                         sets.New(s.SkipTests...),
+		FS:                                *s.FS,
+		UsableNetworkAddresses:            s.UsableNetworkAddresses,
+		UnusableNetworkAddresses:          s.UnusableNetworkAddresses,
+		DiagnosticsDir:                    s.DiagnosticsDir,
+		DiagnosticsRoundTrips:             s.DiagnosticsRoundTrips,
 	}
 
 	// apply defaults
@@ -183,10 +192,62 @@ func NewExperimentalConformanceTestSuite(s ExperimentalConformanceOptions) (*Exp
 	if suite.MeshManifests == "" {
 		suite.MeshManifests = "mesh/manifests.yaml"
 	}
+	if suite.ParallelManifests == "" {
+		suite.ParallelManifests = "base/parallel-manifests.yaml"
+	}
+	if suite.Parallelism < 1 {
+		suite.Parallelism = 1
+	}
+	if suite.DiagnosticsDir == "" {
+		suite.DiagnosticsDir = defaultDiagnosticsDir()
+	}
+	if suite.DiagnosticsRoundTrips < 1 {
+		suite.DiagnosticsRoundTrips = defaultDiagnosticsRoundTrips
+	}
 
 	return suite, nil
 }
 
+// compileProfileFeatures enables the core features of the conformance profiles
+// in the supported features, and sorts their extended features between the
+// supported and unsupported ones for reporting.
+func (suite *ExperimentalConformanceTestSuite) compileProfileFeatures(supportedFeatures, exemptFeatures sets.Set[SupportedFeature]) error {
+	suite.extendedSupportedFeatures = make(map[ConformanceProfileName]sets.Set[SupportedFeature])
+	suite.extendedUnsupportedFeatures = make(map[ConformanceProfileName]sets.Set[SupportedFeature])
+
+	for _, conformanceProfileName := range suite.conformanceProfiles.UnsortedList() {
+		conformanceProfile, err := getConformanceProfileForName(conformanceProfileName)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve conformance profile: %w", err)
+		}
+		// the use of a conformance profile implicitly enables any features of
+		// that profile which are supported at a Core level of support.
+		for _, f := range conformanceProfile.CoreFeatures.UnsortedList() {
+			if !supportedFeatures.Has(f) {
+				supportedFeatures.Insert(f)
+			}
+		}
+		for _, f := range conformanceProfile.ExtendedFeatures.UnsortedList() {
+			if supportedFeatures.Has(f) {
+				if suite.extendedSupportedFeatures[conformanceProfileName] == nil {
+					suite.extendedSupportedFeatures[conformanceProfileName] = sets.New[SupportedFeature]()
+				}
+				suite.extendedSupportedFeatures[conformanceProfileName].Insert(f)
+			} else {
+				if suite.extendedUnsupportedFeatures[conformanceProfileName] == nil {
+					suite.extendedUnsupportedFeatures[conformanceProfileName] = sets.New[SupportedFeature]()
+				}
+				suite.extendedUnsupportedFeatures[conformanceProfileName].Insert(f)
+			}
+			// Add Exempt Features into unsupported features list
+			if exemptFeatures.Has(f) {
+				suite.extendedUnsupportedFeatures[conformanceProfileName].Insert(f)
+			}
+		}
+	}
+	return nil
+}
+
 // -----------------------------------------------------------------------------
 // Conformance Test Suite - Public Methods
 // -----------------------------------------------------------------------------
@@ -194,7 +255,28 @@ func NewExperimentalConformanceTestSuite(s ExperimentalConformanceOptions) (*Exp
 // Setup ensures the base resources required for conformance tests are installed
 // in the cluster. It also ensures that all relevant resources are ready.
 func (suite *ExperimentalConformanceTestSuite) Setup(t *testing.T) {
+	if suite.SupportedFeaturesFromGatewayClass {
+		// the conformance profiles can only be compiled once the features
+		// supported by the GatewayClass are known.
+		suite.setSupportedFeaturesFromGatewayClass(t)
+		if err := suite.compileProfileFeatures(suite.SupportedFeatures, suite.ExemptFeatures); err != nil {
+			t.Fatalf("Error compiling conformance profiles: %v", err)
+		}
+		for feature := range suite.ExemptFeatures {
+			suite.SupportedFeatures.Delete(feature)
+		}
+	}
+
 	suite.ConformanceTestSuite.Setup(t)
+
+	if suite.Clientset != nil {
+		serverVersion, err := suite.Clientset.Discovery().ServerVersion()
+		if err != nil {
+			t.Logf("Test Setup: Failed to get the Kubernetes version, it won't be reported: %v", err)
+			return
+		}
+		suite.kubernetesVersion = serverVersion.GitVersion
+	}
 }
 
 // Run runs the provided set of conformance tests.
@@ -215,10 +297,15 @@ func (suite *ExperimentalConformanceTestSuite) Run(t *testing.T, tests []Conform
 
 	// run all tests and collect the test results for conformance reporting
 	results := make(map[string]testResult)
-	for _, test := range tests {
-		succeeded := t.Run(test.ShortName, func(t *testing.T) {
-			test.Run(t, &suite.ConformanceTestSuite)
-		})
+	var records []TestRecord
+	starts := make(map[string]time.Time)
+	suite.runTests(t, tests, func(test ConformanceTest) {
+		starts[test.ShortName] = time.Now()
+		record := suite.testRecord(test, starts[test.ShortName], time.Time{}, "", "")
+		for _, reporter := range suite.testReporters {
+			reporter.TestStarted(record)
+		}
+	}, func(test ConformanceTest, succeeded bool, failureMessage string) {
 		res := testSucceeded
 		if suite.SkipTests.Has(test.ShortName) {
 			res = testSkipped
@@ -231,11 +318,17 @@ func (suite *ExperimentalConformanceTestSuite) Run(t *testing.T, tests []Conform
 			res = testFailed
 		}
 
+		record := suite.testRecord(test, starts[test.ShortName], time.Now(), res, failureMessage)
+		records = append(records, record)
 		results[test.ShortName] = testResult{
 			test:   test,
 			result: res,
+			record: record,
 		}
-	}
+		for _, reporter := range suite.testReporters {
+			reporter.TestFinished(record)
+		}
+	})
 
 	// now that the tests have completed, mark the test suite as not running
 	// and report the test results.
@@ -244,12 +337,49 @@ func (suite *ExperimentalConformanceTestSuite) Run(t *testing.T, tests []Conform
 	suite.results = results
 	suite.lock.Unlock()
 
-	return nil
+	var errs []error
+	for _, reporter := range suite.testReporters {
+		if err := reporter.RunFinished(records); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// testRecord describes a test for the TestReporters. The end time, result and
+// failure message are only set once the test completed.
+func (suite *ExperimentalConformanceTestSuite) testRecord(test ConformanceTest, start, end time.Time, res resultType, failureMessage string) TestRecord {
+	record := TestRecord{
+		ShortName:   test.ShortName,
+		Description: test.Description,
+		Features:    test.Features,
+		Start:       start,
+		End:         end,
+		Result:      string(res),
+		SkipReason:  test.skipReason(&suite.ConformanceTestSuite),
+	}
+	if record.SkipReason == "" {
+		if test.Parallel {
+			record.Manifests = append(record.Manifests, suite.ParallelManifests)
+		}
+		record.Manifests = append(record.Manifests, test.Manifests...)
+	}
+	if res == testFailed {
+		record.FailureMessage = failureMessage
+		if record.FailureMessage == "" {
+			// Failures that weren't reported through tlog, such as the
+			// ones of t.Fatalf, testify assertions given t or panics, have
+			// no recorded message.
+			record.FailureMessage = fmt.Sprintf("%s failed, see the test output for the failed assertions", test.ShortName)
+		}
+	}
+	return record
 }
 
 // Report emits a ConformanceReport for the previously completed test run.
 // If no run completed prior to running the report, and error is emitted.
-func (suite *ExperimentalConformanceTestSuite) Report() (*confv1a1.ConformanceReport, error) {
+func (suite *ExperimentalConformanceTestSuite) Report() (*confv1a2.ConformanceReport, error) {
 	suite.lock.RLock()
 	if suite.running {
 		suite.lock.RUnlock()
@@ -257,8 +387,17 @@ func (suite *ExperimentalConformanceTestSuite) Report() (*confv1a1.ConformanceRe
 	}
 	defer suite.lock.RUnlock()
 
-	profileReports := newReports()
+	// report the tests in the order they completed
+	results := make([]testResult, 0, len(suite.results))
 	for _, testResult := range suite.results {
+		results = append(results, testResult)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].record.End.Before(results[j].record.End)
+	})
+
+	profileReports := newReports()
+	for _, testResult := range results {
 		conformanceProfiles := getConformanceProfilesForTest(testResult.test, suite.conformanceProfiles)
 		for _, profile := range conformanceProfiles.UnsortedList() {
 			profileReports.addTestResults(*profile, testResult)
@@ -267,21 +406,40 @@ func (suite *ExperimentalConformanceTestSuite) Report() (*confv1a1.ConformanceRe
 
 	profileReports.compileResults(suite.extendedSupportedFeatures, suite.extendedUnsupportedFeatures)
 
-	return &confv1a1.ConformanceReport{
+	return &confv1a2.ConformanceReport{
 		TypeMeta: v1.TypeMeta{
-			APIVersion: "gateway.networking.k8s.io/v1alpha1",
+			APIVersion: confv1a2.GroupVersion,
 			Kind:       "ConformanceReport",
 		},
 		Date:              time.Now().Format(time.RFC3339),
 		Implementation:    suite.implementation,
-		GatewayAPIVersion: "TODO",
-		ProfileReports:    profileReports.list(),
+		GatewayAPIVersion: consts.BundleVersion,
+		Environment: &confv1a2.Environment{
+			KubernetesVersion: suite.kubernetesVersion,
+			GatewayClassName:  suite.GatewayClassName,
+			ControllerName:    suite.ControllerName,
+		},
+		Mode: &confv1a2.Mode{
+			SupportedFeatures: sortedFeatureNames(suite.SupportedFeatures),
+			ExemptFeatures:    sortedFeatureNames(suite.ExemptFeatures),
+			SkippedTests:      sets.List(suite.SkipTests),
+		},
+		ProfileReports: profileReports.list(),
 	}, nil
 }
 
+// sortedFeatureNames returns the sorted names of a set of features.
+func sortedFeatureNames(features sets.Set[SupportedFeature]) []string {
+	var names []string
+	for _, feature := range sets.List(features) {
+		names = append(names, string(feature))
+	}
+	return names
+}
+
 // ParseImplementation parses implementation-specific flag arguments and
-// creates a *confv1a1.Implementation.
-func ParseImplementation(org, project, url, version, contact string) (*confv1a1.Implementation, error) {
+// creates a *confv1a2.Implementation.
+func ParseImplementation(org, project, url, version, contact string) (*confv1a2.Implementation, error) {
 	if org == "" {
 		return nil, errors.New("implementation's organization can not be empty")
 	}
@@ -294,20 +452,27 @@ func ParseImplementation(org, project, url, version, contact string) (*confv1a1.
 	if version == "" {
 		return nil, errors.New("implementation's version can not be empty")
 	}
-	contacts := strings.Split(contact, ",")
+	var contacts []string
+	for _, c := range strings.Split(contact, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			contacts = append(contacts, c)
+		}
+	}
 	if len(contacts) == 0 {
 		return nil, errors.New("implementation's contact can not be empty")
 	}
 
-	// TODO: add data validation https://github.com/kubernetes-sigs/gateway-api/issues/2178
-
-	return &confv1a1.Implementation{
+	implementation := &confv1a2.Implementation{
 		Organization: org,
 		Project:      project,
 		URL:          url,
 		Version:      version,
 		Contact:      contacts,
-	}, nil
+	}
+	if errs := ValidateImplementation(implementation, field.NewPath("implementation")); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return implementation, nil
 }
 
 // ParseConformanceProfiles parses flag arguments and converts the string to
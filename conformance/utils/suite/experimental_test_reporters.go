@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Test Reporters - Public Types
+// -----------------------------------------------------------------------------
+
+// TestRecord describes a single conformance test run, as given to the
+// TestReporters of an ExperimentalConformanceTestSuite.
+type TestRecord struct {
+	ShortName   string
+	Description string
+	Features    []SupportedFeature
+
+	// Manifests are the manifests applied for the test, it is empty when
+	// the test was skipped.
+	Manifests []string
+
+	Start time.Time
+	// End is zero until the test completes.
+	End time.Time
+
+	// Result is one of SUCCEEDED, FAILED, SKIPPED or NOT_SUPPORTED, it is
+	// empty until the test completes.
+	Result string
+
+	SkipReason     string
+	FailureMessage string
+}
+
+// Duration returns how long the test ran.
+func (r TestRecord) Duration() time.Duration {
+	if r.End.IsZero() {
+		return 0
+	}
+	return r.End.Sub(r.Start)
+}
+
+// TestReporter is notified of the tests run by an
+// ExperimentalConformanceTestSuite. Calls are never concurrent, even when
+// tests run in parallel.
+type TestReporter interface {
+	// TestStarted is called when a test starts.
+	TestStarted(record TestRecord)
+	// TestFinished is called with the result of a test.
+	TestFinished(record TestRecord)
+	// RunFinished is called once all tests completed, with the records of
+	// all the tests in the order they finished.
+	RunFinished(records []TestRecord) error
+}
+
+// NewJUnitReporter returns a TestReporter writing a JUnit XML report of the
+// run to w once all tests completed.
+func NewJUnitReporter(w io.Writer) TestReporter {
+	return &junitReporter{w: w}
+}
+
+// NewJSONEventReporter returns a TestReporter streaming a JSON event to w,
+// one per line, when each test starts and finishes.
+func NewJSONEventReporter(w io.Writer) TestReporter {
+	return &jsonEventReporter{encoder: json.NewEncoder(w)}
+}
+
+// -----------------------------------------------------------------------------
+// Test Reporters - Private Types
+// -----------------------------------------------------------------------------
+
+const junitSuiteName = "gateway-api-conformance"
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Skipped    *junitMessage   `xml:"skipped,omitempty"`
+	Failure    *junitMessage   `xml:"failure,omitempty"`
+	SystemOut  string          `xml:"system-out,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+type junitReporter struct {
+	w io.Writer
+}
+
+func (r *junitReporter) TestStarted(TestRecord) {}
+
+func (r *junitReporter) TestFinished(TestRecord) {}
+
+func (r *junitReporter) RunFinished(records []TestRecord) error {
+	suite := junitTestSuite{Name: junitSuiteName}
+	var start, end time.Time
+	for _, record := range records {
+		if start.IsZero() || record.Start.Before(start) {
+			start = record.Start
+		}
+		if record.End.After(end) {
+			end = record.End
+		}
+
+		testCase := junitTestCase{
+			Name:      record.ShortName,
+			ClassName: junitSuiteName,
+			Time:      junitSeconds(record.Duration()),
+			SystemOut: record.Description,
+		}
+		for _, feature := range record.Features {
+			testCase.Properties = append(testCase.Properties, junitProperty{Name: "feature", Value: string(feature)})
+		}
+		for _, manifest := range record.Manifests {
+			testCase.Properties = append(testCase.Properties, junitProperty{Name: "manifest", Value: manifest})
+		}
+
+		switch resultType(record.Result) {
+		case testFailed:
+			suite.Failures++
+			testCase.Failure = &junitMessage{Message: record.FailureMessage}
+		case testSkipped, testNotSupported:
+			suite.Skipped++
+			testCase.Skipped = &junitMessage{Message: record.SkipReason}
+		}
+		suite.Tests++
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	if !start.IsZero() {
+		suite.Timestamp = start.UTC().Format(time.RFC3339)
+	}
+	suite.Time = junitSeconds(end.Sub(start))
+
+	report := junitTestSuites{
+		Name:     junitSuiteName,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Time:     suite.Time,
+		Suites:   []junitTestSuite{suite},
+	}
+	if _, err := io.WriteString(r.w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(r.w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("error writing JUnit report: %w", err)
+	}
+	_, err := io.WriteString(r.w, "\n")
+	return err
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// testEvent is the JSON representation of the start or the end of a test.
+type testEvent struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"`
+	Test   string    `json:"test"`
+
+	Description string             `json:"description,omitempty"`
+	Features    []SupportedFeature `json:"features,omitempty"`
+	Manifests   []string           `json:"manifests,omitempty"`
+
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+	// Elapsed is the duration of the test in seconds.
+	Elapsed *float64 `json:"elapsed,omitempty"`
+
+	Result         string `json:"result,omitempty"`
+	SkipReason     string `json:"skipReason,omitempty"`
+	FailureMessage string `json:"failureMessage,omitempty"`
+}
+
+const (
+	testEventStart  = "start"
+	testEventFinish = "finish"
+)
+
+type jsonEventReporter struct {
+	encoder *json.Encoder
+	// err is the first error writing an event, returned by RunFinished.
+	err error
+}
+
+func (r *jsonEventReporter) TestStarted(record TestRecord) {
+	r.write(testEvent{
+		Time:        record.Start,
+		Action:      testEventStart,
+		Test:        record.ShortName,
+		Description: record.Description,
+		Features:    record.Features,
+	})
+}
+
+func (r *jsonEventReporter) TestFinished(record TestRecord) {
+	elapsed := record.Duration().Seconds()
+	r.write(testEvent{
+		Time:           record.End,
+		Action:         testEventFinish,
+		Test:           record.ShortName,
+		Manifests:      record.Manifests,
+		Start:          &record.Start,
+		End:            &record.End,
+		Elapsed:        &elapsed,
+		Result:         record.Result,
+		SkipReason:     record.SkipReason,
+		FailureMessage: record.FailureMessage,
+	})
+}
+
+func (r *jsonEventReporter) RunFinished([]TestRecord) error {
+	return r.err
+}
+
+func (r *jsonEventReporter) write(event testEvent) {
+	if r.err != nil {
+		return
+	}
+	if err := r.encoder.Encode(event); err != nil {
+		r.err = fmt.Errorf("error writing test event: %w", err)
+	}
+}
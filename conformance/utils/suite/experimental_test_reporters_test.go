@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	reporterStart   = time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+	reporterRecords = []TestRecord{{
+		ShortName:   "HTTPRouteHeaderMatching",
+		Description: "A single HTTPRoute with header matching for different backends",
+		Features:    []SupportedFeature{SupportGateway, SupportHTTPRoute},
+		Manifests:   []string{"tests/httproute-header-matching.yaml"},
+		Start:       reporterStart,
+		End:         reporterStart.Add(1500 * time.Millisecond),
+		Result:      string(testSucceeded),
+	}, {
+		ShortName:      "HTTPRouteMethodMatching",
+		Features:       []SupportedFeature{SupportGateway, SupportHTTPRoute, SupportHTTPRouteMethodMatching},
+		Manifests:      []string{"tests/httproute-method-matching.yaml"},
+		Start:          reporterStart.Add(time.Second),
+		End:            reporterStart.Add(3 * time.Second),
+		Result:         string(testFailed),
+		FailureMessage: "HTTPRouteMethodMatching failed",
+	}, {
+		ShortName:  "HTTPRouteQueryParamMatching",
+		Features:   []SupportedFeature{SupportGateway, SupportHTTPRoute, SupportHTTPRouteQueryParamMatching},
+		Start:      reporterStart.Add(3 * time.Second),
+		End:        reporterStart.Add(3 * time.Second),
+		Result:     string(testNotSupported),
+		SkipReason: "suite does not support HTTPRouteQueryParamMatching",
+	}}
+)
+
+func TestJUnitReporter(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewJUnitReporter(&buf)
+	for _, record := range reporterRecords {
+		reporter.TestStarted(record)
+		reporter.TestFinished(record)
+	}
+	if err := reporter.RunFinished(reporterRecords); err != nil {
+		t.Fatalf("Unexpected error writing the JUnit report: %v", err)
+	}
+
+	var report junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Unexpected error parsing the JUnit report %q: %v", buf.String(), err)
+	}
+	if report.Tests != 3 || report.Failures != 1 || report.Skipped != 1 || report.Time != "3.000" {
+		t.Errorf("Unexpected totals, expected 3 tests, 1 failure and 1 skipped in 3.000s, got: %+v", report)
+	}
+	if len(report.Suites) != 1 || len(report.Suites[0].TestCases) != 3 {
+		t.Fatalf("Expected a single test suite with 3 test cases, got: %+v", report.Suites)
+	}
+	if report.Suites[0].Timestamp != "2023-11-01T10:00:00Z" {
+		t.Errorf("Unexpected timestamp, expected: 2023-11-01T10:00:00Z, got: %s", report.Suites[0].Timestamp)
+	}
+
+	expected := []junitTestCase{{
+		Name:      "HTTPRouteHeaderMatching",
+		ClassName: junitSuiteName,
+		Time:      "1.500",
+		Properties: []junitProperty{
+			{Name: "feature", Value: "Gateway"},
+			{Name: "feature", Value: "HTTPRoute"},
+			{Name: "manifest", Value: "tests/httproute-header-matching.yaml"},
+		},
+		SystemOut: "A single HTTPRoute with header matching for different backends",
+	}, {
+		Name:      "HTTPRouteMethodMatching",
+		ClassName: junitSuiteName,
+		Time:      "2.000",
+		Properties: []junitProperty{
+			{Name: "feature", Value: "Gateway"},
+			{Name: "feature", Value: "HTTPRoute"},
+			{Name: "feature", Value: "HTTPRouteMethodMatching"},
+			{Name: "manifest", Value: "tests/httproute-method-matching.yaml"},
+		},
+		Failure: &junitMessage{Message: "HTTPRouteMethodMatching failed"},
+	}, {
+		Name:      "HTTPRouteQueryParamMatching",
+		ClassName: junitSuiteName,
+		Time:      "0.000",
+		Properties: []junitProperty{
+			{Name: "feature", Value: "Gateway"},
+			{Name: "feature", Value: "HTTPRoute"},
+			{Name: "feature", Value: "HTTPRouteQueryParamMatching"},
+		},
+		Skipped: &junitMessage{Message: "suite does not support HTTPRouteQueryParamMatching"},
+	}}
+	if !reflect.DeepEqual(report.Suites[0].TestCases, expected) {
+		t.Errorf("Unexpected test cases, expected: %+v, got: %+v", expected, report.Suites[0].TestCases)
+	}
+}
+
+func TestJSONEventReporter(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewJSONEventReporter(&buf)
+	for _, record := range reporterRecords[:2] {
+		started := record
+		started.End = time.Time{}
+		started.Result = ""
+		reporter.TestStarted(started)
+		reporter.TestFinished(record)
+	}
+	if err := reporter.RunFinished(reporterRecords[:2]); err != nil {
+		t.Fatalf("Unexpected error writing the JSON events: %v", err)
+	}
+
+	var events []testEvent
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var event testEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("Unexpected error parsing the event %q: %v", scanner.Text(), err)
+		}
+		events = append(events, event)
+	}
+
+	actions := []string{}
+	for _, event := range events {
+		actions = append(actions, event.Action+" "+event.Test)
+	}
+	expectedActions := []string{
+		"start HTTPRouteHeaderMatching",
+		"finish HTTPRouteHeaderMatching",
+		"start HTTPRouteMethodMatching",
+		"finish HTTPRouteMethodMatching",
+	}
+	if !reflect.DeepEqual(actions, expectedActions) {
+		t.Fatalf("Unexpected events, expected: %v, got: %v", expectedActions, actions)
+	}
+
+	if !events[0].Time.Equal(reporterStart) || events[0].Elapsed != nil || events[0].Result != "" {
+		t.Errorf("Unexpected start event: %+v", events[0])
+	}
+	finished := events[3]
+	if finished.Elapsed == nil || *finished.Elapsed != 2 {
+		t.Errorf("Expected the test to have run for 2s, got: %+v", finished.Elapsed)
+	}
+	if finished.Result != string(testFailed) || finished.FailureMessage != "HTTPRouteMethodMatching failed" {
+		t.Errorf("Unexpected result for the failed test: %+v", finished)
+	}
+	if !reflect.DeepEqual(finished.Manifests, []string{"tests/httproute-method-matching.yaml"}) {
+		t.Errorf("Unexpected manifests for the failed test: %v", finished.Manifests)
+	}
+}
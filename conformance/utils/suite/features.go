@@ -177,6 +177,23 @@ var HTTPRouteExperimentalFeatures = sets.New(
 	SupportHTTPRouteBackendProtocolWebSocket,
 )
 
+// -----------------------------------------------------------------------------
+// Features - BackendTLSPolicy Conformance (Experimental)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for BackendTLSPolicy, with the Gateway
+	// originating TLS to Service backends validated with caCertRefs.
+	SupportBackendTLSPolicy SupportedFeature = "BackendTLSPolicy"
+)
+
+// BackendTLSPolicyExperimentalFeatures includes all the supported features for
+// the BackendTLSPolicy API, currently only available in our experimental
+// release channel.
+var BackendTLSPolicyExperimentalFeatures = sets.New(
+	SupportBackendTLSPolicy,
+)
+
 // -----------------------------------------------------------------------------
 // Features - TLSRoute Conformance (Core)
 // -----------------------------------------------------------------------------
@@ -192,6 +209,72 @@ var TLSRouteCoreFeatures = sets.New(
 	SupportTLSRoute,
 )
 
+// -----------------------------------------------------------------------------
+// Features - TCPRoute Conformance (Core)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for TCPRoute
+	SupportTCPRoute SupportedFeature = "TCPRoute"
+)
+
+// TCPRouteCoreFeatures includes all the supported features for the TCPRoute
+// API at a Core level of support.
+var TCPRouteCoreFeatures = sets.New(
+	SupportTCPRoute,
+)
+
+// -----------------------------------------------------------------------------
+// Features - UDPRoute Conformance (Core)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for UDPRoute
+	SupportUDPRoute SupportedFeature = "UDPRoute"
+)
+
+// UDPRouteCoreFeatures includes all the supported features for the UDPRoute
+// API at a Core level of support.
+var UDPRouteCoreFeatures = sets.New(
+	SupportUDPRoute,
+)
+
+// -----------------------------------------------------------------------------
+// Features - GRPCRoute Conformance (Core)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for GRPCRoute
+	SupportGRPCRoute SupportedFeature = "GRPCRoute"
+)
+
+// GRPCRouteCoreFeatures includes all SupportedFeatures needed to be conformant with
+// the GRPCRoute resource.
+var GRPCRouteCoreFeatures = sets.New(
+	SupportGRPCRoute,
+)
+
+// -----------------------------------------------------------------------------
+// Features - GRPCRoute Conformance (Extended)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for GRPCRoute response header modification (extended conformance).
+	SupportGRPCRouteResponseHeaderModification SupportedFeature = "GRPCRouteResponseHeaderModification"
+
+	// This option indicates support for GRPCRoute RegularExpression method matching
+	// (implementation-specific conformance).
+	SupportGRPCRouteMethodRegexMatching SupportedFeature = "GRPCRouteMethodRegexMatching"
+)
+
+// GRPCRouteExtendedFeatures includes all the supported features for GRPCRoute
+// conformance and can be used to opt-in to run all GRPCRoute tests, including
+// extended and implementation-specific features.
+var GRPCRouteExtendedFeatures = sets.New(
+	SupportGRPCRouteResponseHeaderModification,
+	SupportGRPCRouteMethodRegexMatching,
+)
+
 // -----------------------------------------------------------------------------
 // Features - Mesh Conformance (Core)
 // -----------------------------------------------------------------------------
@@ -221,5 +304,22 @@ var AllFeatures = sets.New[SupportedFeature]().
 	Insert(HTTPRouteCoreFeatures.UnsortedList()...).
 	Insert(HTTPRouteExtendedFeatures.UnsortedList()...).
 	Insert(HTTPRouteExperimentalFeatures.UnsortedList()...).
+	Insert(BackendTLSPolicyExperimentalFeatures.UnsortedList()...).
 	Insert(TLSRouteCoreFeatures.UnsortedList()...).
 	Insert(MeshCoreFeatures.UnsortedList()...)
+
+// EchoListenerFeatures contains the features whose tests need the gRPC, TCP
+// and UDP listeners of the echo-basic image. The image referenced by the base
+// manifests doesn't have them yet, so these features are left out of
+// AllFeatures: their
+// tests only run when the features are explicitly enabled, against an
+// echo-basic image built from conformance/echo-basic.
+var EchoListenerFeatures = sets.New[SupportedFeature]().
+	Insert(GRPCRouteCoreFeatures.UnsortedList()...).
+	Insert(GRPCRouteExtendedFeatures.UnsortedList()...).
+	Insert(TCPRouteCoreFeatures.UnsortedList()...).
+	Insert(UDPRouteCoreFeatures.UnsortedList()...)
+
+// KnownFeatures contains all the features known to this version of the test
+// suite, including the EchoListenerFeatures.
+var KnownFeatures = AllFeatures.Union(EchoListenerFeatures)
@@ -17,20 +17,28 @@ limitations under the License.
 package suite
 
 import (
+	"context"
 	"embed"
+	"fmt"
+	"hash/fnv"
 	"strings"
+	"sync"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/gateway-api/conformance"
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
 	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
 	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
 )
 
 // ConformanceTestSuite defines the test suite used to run Gateway API
@@ -41,22 +49,46 @@ type ConformanceTestSuite struct {
 	RESTClient               *rest.RESTClient
 	RestConfig               *rest.Config
 	RoundTripper             roundtripper.RoundTripper
+	GRPCRoundTripper         roundtripper.GRPCRoundTripper
+	L4RoundTripper           roundtripper.L4RoundTripper
 	GatewayClassName         string
 	ControllerName           string
 	Debug                    bool
 	Cleanup                  bool
 	BaseManifests            string
 	MeshManifests            string
+	ParallelManifests        string
+	Parallelism              int
 	Applier                  kubernetes.Applier
 	SupportedFeatures        sets.Set[SupportedFeature]
+	ExemptFeatures           sets.Set[SupportedFeature]
 	TimeoutConfig            config.TimeoutConfig
 	SkipTests                sets.Set[string]
 	RunTest                  string
 	FS                       embed.FS
 	UsableNetworkAddresses   []v1beta1.GatewayAddress
 	UnusableNetworkAddresses []v1beta1.GatewayAddress
+
+	// SupportedFeaturesFromGatewayClass indicates the supported features are
+	// read from the status of the GatewayClass during Setup.
+	SupportedFeaturesFromGatewayClass bool
+
+	// DiagnosticsDir is the directory the diagnostics bundles of the failed
+	// tests are written to, in a directory per test.
+	DiagnosticsDir string
+	// DiagnosticsRoundTrips is the number of the last round trips of a failed
+	// test included in its diagnostics bundle.
+	DiagnosticsRoundTrips int
+
+	// gatewayClassFeatures are the features the GatewayClass claims to
+	// support, set during Setup when SupportedFeaturesFromGatewayClass is set.
+	gatewayClassFeatures sets.Set[SupportedFeature]
 }
 
+// SupportedFeaturesFromGatewayClass is the value of the supported features
+// flag reading them from the status of the GatewayClass.
+const SupportedFeaturesFromGatewayClass = "from-gatewayclass"
+
 // Options can be used to initialize a ConformanceTestSuite.
 type Options struct {
 	Client               client.Client
@@ -65,18 +97,32 @@ type Options struct {
 	GatewayClassName     string
 	Debug                bool
 	RoundTripper         roundtripper.RoundTripper
+	GRPCRoundTripper     roundtripper.GRPCRoundTripper
+	L4RoundTripper       roundtripper.L4RoundTripper
 	BaseManifests        string
 	MeshManifests        string
 	NamespaceLabels      map[string]string
 	NamespaceAnnotations map[string]string
 
+	// ParallelManifests are the base resources applied to the isolated
+	// namespace of each test that runs in parallel.
+	ParallelManifests string
+	// Parallelism is the maximum number of tests run in parallel. Only tests
+	// that set ConformanceTest.Parallel run in parallel, it defaults to 1.
+	Parallelism int
+
 	// CleanupBaseResources indicates whether or not the base test
 	// resources such as Gateways should be cleaned up after the run.
 	CleanupBaseResources       bool
 	SupportedFeatures          sets.Set[SupportedFeature]
 	ExemptFeatures             sets.Set[SupportedFeature]
 	EnableAllSupportedFeatures bool
-	TimeoutConfig              config.TimeoutConfig
+	// SupportedFeaturesFromGatewayClass reads the supported features from
+	// the status of the GatewayClass during Setup, instead of using
+	// SupportedFeatures or EnableAllSupportedFeatures. The run fails if the tests of any of the features
+	// the GatewayClass claims to support fail.
+	SupportedFeaturesFromGatewayClass bool
+	TimeoutConfig                     config.TimeoutConfig
 	// SkipTests contains all the tests not to be run and can be used to opt out
 	// of specific tests
 	SkipTests []string
@@ -93,6 +139,16 @@ type Options struct {
 	// Gateways for tests which need to test failures with manual Gateway
 	// address assignment.
 	UnusableNetworkAddresses []v1beta1.GatewayAddress
+
+	// DiagnosticsDir is the directory the diagnostics bundles of the failed
+	// tests are written to, in a directory per test. Each bundle contains the
+	// Gateway API resources and the Events of the test namespaces, the logs
+	// of the echo pods and the last round trips of the test. It defaults to a
+	// directory in the system temporary directory.
+	DiagnosticsDir string
+	// DiagnosticsRoundTrips is the number of the last round trips of a failed
+	// test included in its diagnostics bundle, it defaults to 10.
+	DiagnosticsRoundTrips int
 }
 
 // New returns a new ConformanceTestSuite.
@@ -104,6 +160,16 @@ func New(s Options) *ConformanceTestSuite {
 		roundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
 	}
 
+	grpcRoundTripper := s.GRPCRoundTripper
+	if grpcRoundTripper == nil {
+		grpcRoundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
+	l4RoundTripper := s.L4RoundTripper
+	if l4RoundTripper == nil {
+		l4RoundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
 	switch {
 	case s.EnableAllSupportedFeatures:
 		s.SupportedFeatures = AllFeatures
@@ -124,26 +190,34 @@ func New(s Options) *ConformanceTestSuite {
 	}
 
 	suite := &ConformanceTestSuite{
-		Client:           s.Client,
-		Clientset:        s.Clientset,
-		RestConfig:       s.RestConfig,
-		RoundTripper:     roundTripper,
-		GatewayClassName: s.GatewayClassName,
-		Debug:            s.Debug,
-		Cleanup:          s.CleanupBaseResources,
-		BaseManifests:    s.BaseManifests,
-		MeshManifests:    s.MeshManifests,
+		Client:            s.Client,
+		Clientset:         s.Clientset,
+		RestConfig:        s.RestConfig,
+		RoundTripper:      roundTripper,
+		GRPCRoundTripper:  grpcRoundTripper,
+		L4RoundTripper:    l4RoundTripper,
+		GatewayClassName:  s.GatewayClassName,
+		Debug:             s.Debug,
+		Cleanup:           s.CleanupBaseResources,
+		BaseManifests:     s.BaseManifests,
+		MeshManifests:     s.MeshManifests,
+		ParallelManifests: s.ParallelManifests,
+		Parallelism:       s.Parallelism,
 		Applier: kubernetes.Applier{
 			NamespaceLabels:      s.NamespaceLabels,
 			NamespaceAnnotations: s.NamespaceAnnotations,
 		},
-		SupportedFeatures:        s.SupportedFeatures,
-		TimeoutConfig:            s.TimeoutConfig,
-		SkipTests:                sets.New(s.SkipTests...),
-		RunTest:                  s.RunTest,
-		FS:                       *s.FS,
-		UsableNetworkAddresses:   s.UsableNetworkAddresses,
-		UnusableNetworkAddresses: s.UnusableNetworkAddresses,
+		SupportedFeatures:                 s.SupportedFeatures,
+		ExemptFeatures:                    s.ExemptFeatures,
+		SupportedFeaturesFromGatewayClass: s.SupportedFeaturesFromGatewayClass,
+		TimeoutConfig:                     s.TimeoutConfig,
+		SkipTests:                         sets.New(s.SkipTests...),
+		RunTest:                           s.RunTest,
+		FS:                                *s.FS,
+		UsableNetworkAddresses:            s.UsableNetworkAddresses,
+		UnusableNetworkAddresses:          s.UnusableNetworkAddresses,
+		DiagnosticsDir:                    s.DiagnosticsDir,
+		DiagnosticsRoundTrips:             s.DiagnosticsRoundTrips,
 	}
 
 	// apply defaults
@@ -153,6 +227,18 @@ func New(s Options) *ConformanceTestSuite {
 	if suite.MeshManifests == "" {
 		suite.MeshManifests = "mesh/manifests.yaml"
 	}
+	if suite.ParallelManifests == "" {
+		suite.ParallelManifests = "base/parallel-manifests.yaml"
+	}
+	if suite.Parallelism < 1 {
+		suite.Parallelism = 1
+	}
+	if suite.DiagnosticsDir == "" {
+		suite.DiagnosticsDir = defaultDiagnosticsDir()
+	}
+	if suite.DiagnosticsRoundTrips < 1 {
+		suite.DiagnosticsRoundTrips = defaultDiagnosticsRoundTrips
+	}
 
 	return suite
 }
@@ -164,6 +250,10 @@ func (suite *ConformanceTestSuite) Setup(t *testing.T) {
 	suite.Applier.UsableNetworkAddresses = suite.UsableNetworkAddresses
 	suite.Applier.UnusableNetworkAddresses = suite.UnusableNetworkAddresses
 
+	if suite.SupportedFeaturesFromGatewayClass && suite.gatewayClassFeatures == nil {
+		suite.setSupportedFeaturesFromGatewayClass(t)
+	}
+
 	if suite.SupportedFeatures.Has(SupportGateway) {
 		t.Logf("Test Setup: Ensuring GatewayClass has been accepted")
 		suite.ControllerName = kubernetes.GWCMustHaveAcceptedConditionTrue(t, suite.Client, suite.TimeoutConfig, suite.GatewayClassName)
@@ -183,6 +273,14 @@ func (suite *ConformanceTestSuite) Setup(t *testing.T) {
 		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
 		secret = kubernetes.MustCreateSelfSignedCertSecret(t, "gateway-conformance-app-backend", "tls-passthrough-checks-certificate", []string{"abc.example.com"})
 		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
+		if suite.SupportedFeatures.Has(SupportBackendTLSPolicy) {
+			ca := kubernetes.MustCreateCertificateAuthority(t)
+			secret = kubernetes.MustCreateCASignedCertSecret(t, "gateway-conformance-infra", "backend-tls-checks-certificate", []string{"abc.example.com"}, ca)
+			caConfigMap := kubernetes.MustCreateCACertConfigMap(t, "gateway-conformance-infra", "backend-tls-checks-ca", ca)
+			// The mismatched CA did not sign the certificate of the backend.
+			mismatchedCAConfigMap := kubernetes.MustCreateCACertConfigMap(t, "gateway-conformance-infra", "backend-tls-checks-mismatched-ca", kubernetes.MustCreateCertificateAuthority(t))
+			suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret, caConfigMap, mismatchedCAConfigMap}, suite.Cleanup)
+		}
 
 		t.Logf("Test Setup: Ensuring Gateways and Pods from base manifests are ready")
 		namespaces := []string{
@@ -206,15 +304,190 @@ func (suite *ConformanceTestSuite) Setup(t *testing.T) {
 	}
 }
 
+// setSupportedFeaturesFromGatewayClass replaces the supported features of the
+// suite with the features listed in the status of the accepted GatewayClass.
+func (suite *ConformanceTestSuite) setSupportedFeaturesFromGatewayClass(t *testing.T) {
+	t.Helper()
+
+	t.Logf("Test Setup: Reading supported features from GatewayClass %s", suite.GatewayClassName)
+	kubernetes.GWCMustHaveAcceptedConditionTrue(t, suite.Client, suite.TimeoutConfig, suite.GatewayClassName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.GetTimeout)
+	defer cancel()
+	gwc := &v1.GatewayClass{}
+	if err := suite.Client.Get(ctx, types.NamespacedName{Name: suite.GatewayClassName}, gwc); err != nil {
+		t.Fatalf("Error fetching GatewayClass %s: %v", suite.GatewayClassName, err)
+	}
+
+	features, unknown, err := gatewayClassSupportedFeatures(gwc)
+	if err != nil {
+		t.Fatalf("Error reading supported features: %v", err)
+	}
+	if len(unknown) > 0 {
+		t.Logf("Test Setup: Ignoring the features of GatewayClass %s unknown to this version of the suite: %s", suite.GatewayClassName, strings.Join(unknown, ", "))
+	}
+	t.Logf("Test Setup: GatewayClass %s supports %s", suite.GatewayClassName, strings.Join(sortedFeatureNames(features), ", "))
+
+	suite.gatewayClassFeatures = features
+	suite.SupportedFeatures = features.Union(GatewayCoreFeatures).Difference(suite.ExemptFeatures)
+}
+
+// gatewayClassSupportedFeatures returns the features listed in the status of
+// the GatewayClass that are known conformance features, and the unknown ones,
+// which newer implementations can list when running an older suite.
+func gatewayClassSupportedFeatures(gwc *v1.GatewayClass) (sets.Set[SupportedFeature], []string, error) {
+	if len(gwc.Status.SupportedFeatures) == 0 {
+		return nil, nil, fmt.Errorf("GatewayClass %s does not list any supported features in its status", gwc.Name)
+	}
+	features := sets.New[SupportedFeature]()
+	var unknown []string
+	for _, f := range gwc.Status.SupportedFeatures {
+		feature := SupportedFeature(f)
+		if !KnownFeatures.Has(feature) {
+			unknown = append(unknown, string(f))
+			continue
+		}
+		features.Insert(feature)
+	}
+	return features, unknown, nil
+}
+
 // Run runs the provided set of conformance tests.
 func (suite *ConformanceTestSuite) Run(t *testing.T, tests []ConformanceTest) {
-	for _, test := range tests {
-		t.Run(test.ShortName, func(t *testing.T) {
+	suite.runTests(t, tests, func(ConformanceTest) {}, func(ConformanceTest, bool, string) {})
+}
+
+// runTests runs the tests in order, then the tests that can run in parallel
+// with at most Parallelism of them at a time. The started and done functions
+// are called when each test starts and with its result and the last failure
+// message it recorded, never concurrently.
+func (suite *ConformanceTestSuite) runTests(t *testing.T, tests []ConformanceTest, started func(test ConformanceTest), done func(test ConformanceTest, succeeded bool, failureMessage string)) {
+	var failedTests []ConformanceTest
+	// run runs the test and returns whether it succeeded, with its last
+	// failure message otherwise.
+	run := func(test ConformanceTest) (bool, string) {
+		var name string
+		succeeded := t.Run(test.ShortName, func(t *testing.T) {
+			name = t.Name()
+			tlog.Reset(name)
 			test.Run(t, suite)
 		})
+		if succeeded {
+			return true, ""
+		}
+		return false, tlog.FailureMessage(name)
+	}
+	finished := func(test ConformanceTest, succeeded bool, failureMessage string) {
+		if !succeeded {
+			failedTests = append(failedTests, test)
+		}
+		done(test, succeeded, failureMessage)
+	}
+
+	var parallelTests []ConformanceTest
+	for _, test := range tests {
+		if test.Parallel {
+			parallelTests = append(parallelTests, test)
+			continue
+		}
+		started(test)
+		succeeded, failureMessage := run(test)
+		finished(test, succeeded, failureMessage)
+	}
+
+	parallelism := suite.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	// t.Run can be called concurrently from multiple goroutines, as long as
+	// all calls return before the parent test does. This keeps the names of
+	// the tests unchanged, unlike grouping them to use t.Parallel.
+	var (
+		wg        sync.WaitGroup
+		lock      sync.Mutex
+		semaphore = make(chan struct{}, parallelism)
+	)
+	for _, test := range parallelTests {
+		test := test
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			lock.Lock()
+			started(test)
+			lock.Unlock()
+			succeeded, failureMessage := run(test)
+			lock.Lock()
+			defer lock.Unlock()
+			finished(test, succeeded, failureMessage)
+		}()
+	}
+	wg.Wait()
+
+	if features := suite.failedGatewayClassFeatures(failedTests); features.Len() > 0 {
+		t.Errorf("GatewayClass %s claims support for features with failed tests: %s", suite.GatewayClassName, strings.Join(sortedFeatureNames(features), ", "))
 	}
 }
 
+// failedGatewayClassFeatures returns the features claimed by the GatewayClass
+// that are exercised by any of the failed tests.
+func (suite *ConformanceTestSuite) failedGatewayClassFeatures(failedTests []ConformanceTest) sets.Set[SupportedFeature] {
+	features := sets.New[SupportedFeature]()
+	for _, test := range failedTests {
+		for _, feature := range test.Features {
+			if suite.gatewayClassFeatures.Has(feature) {
+				features.Insert(feature)
+			}
+		}
+	}
+	return features
+}
+
+// Namespace returns the namespace the resources of the given manifest
+// namespace are applied to. Tests that run in parallel use it to find their
+// isolated namespace, for other tests it returns the namespace unchanged.
+func (suite *ConformanceTestSuite) Namespace(name string) string {
+	if ns, ok := suite.Applier.NamespaceMapping[name]; ok {
+		return ns
+	}
+	return name
+}
+
+// isolate makes the suite apply the manifests of the test to its own copy of
+// the gateway-conformance-infra namespace, whose base resources are applied by
+// applyParallelManifests.
+func (suite *ConformanceTestSuite) isolate(test *ConformanceTest) {
+	suite.Applier.NamespaceMapping = map[string]string{
+		"gateway-conformance-infra": isolatedNamespaceName("gateway-conformance-infra", test.ShortName),
+	}
+}
+
+// applyParallelManifests applies the ParallelManifests to the isolated
+// namespace of the suite and waits for it to be ready.
+func (suite *ConformanceTestSuite) applyParallelManifests(t *testing.T) {
+	ns := suite.Namespace("gateway-conformance-infra")
+	t.Logf("Applying %s to isolated namespace %s", suite.ParallelManifests, ns)
+	suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.ParallelManifests, true)
+	kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
+}
+
+// isolatedNamespaceName returns the name of the namespace isolating the
+// resources of a test, hashing the name of the test when it is too long for
+// a namespace name.
+func isolatedNamespaceName(namespace, shortName string) string {
+	name := fmt.Sprintf("%s-%s", namespace, strings.ToLower(shortName))
+	if len(name) <= validation.DNS1123LabelMaxLength {
+		return name
+	}
+	h := fnv.New32a()
+	h.Write([]byte(shortName))
+	return fmt.Sprintf("%s-%08x", name[:validation.DNS1123LabelMaxLength-9], h.Sum32())
+}
+
 // ConformanceTest is used to define each individual conformance test.
 type ConformanceTest struct {
 	ShortName   string
@@ -222,42 +495,85 @@ type ConformanceTest struct {
 	Features    []SupportedFeature
 	Manifests   []string
 	Slow        bool
-	Parallel    bool
-	Test        func(*testing.T, *ConformanceTestSuite)
+	// Parallel indicates the test can run in parallel with other tests. Its
+	// manifests are applied to an isolated copy of the
+	// gateway-conformance-infra namespace, which the test must look up with
+	// ConformanceTestSuite.Namespace.
+	Parallel bool
+	Test     func(*testing.T, *ConformanceTestSuite)
 }
 
 // Run runs an individual tests, applying and cleaning up the required manifests
 // before calling the Test function.
 func (test *ConformanceTest) Run(t *testing.T, suite *ConformanceTestSuite) {
+	if reason := test.skipReason(suite); reason != "" {
+		t.Skipf("Skipping %s: %s", test.ShortName, reason)
+	}
+
+	testSuite := *suite
+	suite = &testSuite
 	if test.Parallel {
-		t.Parallel()
+		suite.isolate(test)
+	}
+
+	// record the round trips of the test for its diagnostics bundle.
+	recorder := roundtripper.NewRecordingRoundTripper(suite.RoundTripper, suite.GRPCRoundTripper, suite.L4RoundTripper, suite.DiagnosticsRoundTrips)
+	suite.RoundTripper = recorder
+	suite.GRPCRoundTripper = recorder
+	suite.L4RoundTripper = recorder
+
+	// The diagnostics must be collected before the resources of the test are
+	// deleted by the cleanup functions. Failures up to the end of the Test
+	// function, including while applying the manifests, are collected by the
+	// deferred function, which runs first. Failures of the subtests run in
+	// parallel, which only run once the Test function returned, are collected
+	// by the cleanup function registered after the manifests are applied,
+	// since cleanup functions run in reverse order.
+	collected := false
+	collectDiagnostics := func() {
+		if t.Failed() && !collected {
+			collected = true
+			suite.collectDiagnostics(t, test, recorder)
+		}
+	}
+	defer collectDiagnostics()
+
+	if test.Parallel {
+		suite.applyParallelManifests(t)
+	}
+	for _, manifestLocation := range test.Manifests {
+		t.Logf("Applying %s", manifestLocation)
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, manifestLocation, true)
 	}
+	t.Cleanup(collectDiagnostics)
 
+	test.Test(t, suite)
+}
+
+// skipReason returns why the test is skipped by the suite, or an empty string
+// if the test runs.
+func (test *ConformanceTest) skipReason(suite *ConformanceTestSuite) string {
 	// Check that all features exercised by the test have been opted into by
 	// the suite.
 	for _, feature := range test.Features {
 		if !suite.SupportedFeatures.Has(feature) {
-			t.Skipf("Skipping %s: suite does not support %s", test.ShortName, feature)
+			return fmt.Sprintf("suite does not support %s", feature)
 		}
 	}
 
 	// check that the test should not be skipped
 	if suite.SkipTests.Has(test.ShortName) || suite.RunTest != "" && suite.RunTest != test.ShortName {
-		t.Skipf("Skipping %s: test explicitly skipped", test.ShortName)
-	}
-
-	for _, manifestLocation := range test.Manifests {
-		t.Logf("Applying %s", manifestLocation)
-		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, manifestLocation, true)
+		return "test explicitly skipped"
 	}
 
-	test.Test(t, suite)
+	return ""
 }
 
 // ParseSupportedFeatures parses flag arguments and converts the string to
-// sets.Set[suite.SupportedFeature]
+// sets.Set[suite.SupportedFeature]. It returns nil for
+// SupportedFeaturesFromGatewayClass, the features are then read during Setup.
 func ParseSupportedFeatures(f string) sets.Set[SupportedFeature] {
-	if f == "" {
+	if f == "" || f == SupportedFeaturesFromGatewayClass {
 		return nil
 	}
 	res := sets.Set[SupportedFeature]{}
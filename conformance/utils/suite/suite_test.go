@@ -20,7 +20,10 @@ import (
 	"reflect"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 func TestParseSupportedFeatures(t *testing.T) {
@@ -28,6 +31,7 @@ func TestParseSupportedFeatures(t *testing.T) {
 		"",
 		"a",
 		"b,c,d",
+		SupportedFeaturesFromGatewayClass,
 	}
 
 	s1 := sets.Set[SupportedFeature]{}
@@ -36,7 +40,7 @@ func TestParseSupportedFeatures(t *testing.T) {
 	s2.Insert(SupportedFeature("b"))
 	s2.Insert(SupportedFeature("c"))
 	s2.Insert(SupportedFeature("d"))
-	features := []sets.Set[SupportedFeature]{nil, s1, s2}
+	features := []sets.Set[SupportedFeature]{nil, s1, s2, nil}
 
 	for i, f := range flags {
 		expect := features[i]
@@ -67,3 +71,105 @@ func TestParseKeyValuePairs(t *testing.T) {
 		}
 	}
 }
+
+func TestIsolatedNamespaceName(t *testing.T) {
+	tests := []struct {
+		shortName string
+		expected  string
+	}{
+		{"HTTPRouteHeaderMatching", "gateway-conformance-infra-httprouteheadermatching"},
+		{"HTTPRouteRequestHeaderModifierBackendWeights", "gateway-conformance-infra-httprouterequestheadermodifi-15b12dc6"},
+	}
+
+	for _, tc := range tests {
+		got := isolatedNamespaceName("gateway-conformance-infra", tc.shortName)
+		if got != tc.expected {
+			t.Errorf("Unexpected namespace for test %s, expected: %s, got: %s", tc.shortName, tc.expected, got)
+		}
+		if len(got) > 63 {
+			t.Errorf("Namespace %s for test %s is longer than 63 characters", got, tc.shortName)
+		}
+	}
+}
+
+func TestGatewayClassSupportedFeatures(t *testing.T) {
+	tests := []struct {
+		name              string
+		supportedFeatures []v1.SupportedFeature
+		expected          sets.Set[SupportedFeature]
+		expectedUnknown   []string
+		expectedErr       string
+	}{{
+		name:              "known features",
+		supportedFeatures: []v1.SupportedFeature{"Gateway", "HTTPRoute", "HTTPRouteMethodMatching"},
+		expected:          sets.New(SupportGateway, SupportHTTPRoute, SupportHTTPRouteMethodMatching),
+	}, {
+		name:              "unknown features",
+		supportedFeatures: []v1.SupportedFeature{"Gateway", "HTTPRouteTeleportation", "Holograms"},
+		expected:          sets.New(SupportGateway),
+		expectedUnknown:   []string{"HTTPRouteTeleportation", "Holograms"},
+	}, {
+		name:        "no features",
+		expectedErr: "GatewayClass example does not list any supported features in its status",
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gwc := &v1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				Status:     v1.GatewayClassStatus{SupportedFeatures: tc.supportedFeatures},
+			}
+			got, unknown, err := gatewayClassSupportedFeatures(gwc)
+			if tc.expectedErr != "" {
+				if err == nil || err.Error() != tc.expectedErr {
+					t.Fatalf("Expected error %q, got: %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Unexpected features, expected: %v, got: %v", sets.List(tc.expected), sets.List(got))
+			}
+			if !reflect.DeepEqual(unknown, tc.expectedUnknown) {
+				t.Errorf("Unexpected unknown features, expected: %v, got: %v", tc.expectedUnknown, unknown)
+			}
+		})
+	}
+}
+
+func TestFailedGatewayClassFeatures(t *testing.T) {
+	failedTests := []ConformanceTest{{
+		ShortName: "HTTPRouteMethodMatching",
+		Features:  []SupportedFeature{SupportGateway, SupportHTTPRoute, SupportHTTPRouteMethodMatching},
+	}, {
+		ShortName: "HTTPRouteQueryParamMatching",
+		Features:  []SupportedFeature{SupportGateway, SupportHTTPRoute, SupportHTTPRouteQueryParamMatching},
+	}}
+
+	suite := &ConformanceTestSuite{}
+	if got := suite.failedGatewayClassFeatures(failedTests); got.Len() != 0 {
+		t.Errorf("Expected no failed features without GatewayClass features, got: %v", sets.List(got))
+	}
+
+	suite.gatewayClassFeatures = sets.New(SupportGateway, SupportHTTPRoute, SupportHTTPRouteMethodMatching)
+	expected := sets.New(SupportGateway, SupportHTTPRoute, SupportHTTPRouteMethodMatching)
+	if got := suite.failedGatewayClassFeatures(failedTests); !got.Equal(expected) {
+		t.Errorf("Unexpected failed features, expected: %v, got: %v", sets.List(expected), sets.List(got))
+	}
+}
+
+func TestEchoListenerFeatures(t *testing.T) {
+	if features := AllFeatures.Intersection(EchoListenerFeatures); features.Len() != 0 {
+		t.Errorf("Expected the features needing the echo-basic listeners to be left out of AllFeatures, got: %v", sets.List(features))
+	}
+	if !KnownFeatures.IsSuperset(EchoListenerFeatures) {
+		t.Errorf("Expected the features needing the echo-basic listeners to be known features")
+	}
+	for _, name := range []ConformanceProfileName{GRPCConformanceProfileName, TCPConformanceProfileName, UDPConformanceProfileName} {
+		if _, err := getConformanceProfileForName(name); err == nil {
+			t.Errorf("Expected the %s profile to be left out of the conformance profiles", name)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tlog wraps the functions of *testing.T reporting failures to record
+// the failure messages of the conformance tests, which the testing package
+// doesn't expose, so that they can be included in the test reports.
+//
+// Using it is optional: the reports of tests failing without having recorded
+// a message, such as tests calling t.Fatalf directly, tests of other projects
+// or tests that panicked, only say that the test failed and refer to the test
+// output for the failed assertions.
+package tlog
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var (
+	lock sync.Mutex
+	// failures are the last failure messages recorded for each test and each
+	// of its parents, by test name.
+	failures = map[string]string{}
+)
+
+// Error records the failure message of the test and calls t.Error.
+func Error(t *testing.T, args ...any) {
+	t.Helper()
+	msg := fmt.Sprint(args...)
+	record(t, msg)
+	t.Error(msg)
+}
+
+// Errorf records the failure message of the test and calls t.Error.
+func Errorf(t *testing.T, format string, args ...any) {
+	t.Helper()
+	msg := fmt.Sprintf(format, args...)
+	record(t, msg)
+	t.Error(msg)
+}
+
+// Fatal records the failure message of the test and calls t.Fatal.
+func Fatal(t *testing.T, args ...any) {
+	t.Helper()
+	msg := fmt.Sprint(args...)
+	record(t, msg)
+	t.Fatal(msg)
+}
+
+// Fatalf records the failure message of the test and calls t.Fatal.
+func Fatalf(t *testing.T, format string, args ...any) {
+	t.Helper()
+	msg := fmt.Sprintf(format, args...)
+	record(t, msg)
+	t.Fatal(msg)
+}
+
+// FailureMessage returns the last failure message recorded for the test with
+// the given name or any of its subtests, or an empty string if there is none.
+func FailureMessage(name string) string {
+	lock.Lock()
+	defer lock.Unlock()
+	return failures[name]
+}
+
+// Reset forgets the failure messages recorded for the test with the given
+// name, for instance before it is run again.
+func Reset(name string) {
+	lock.Lock()
+	defer lock.Unlock()
+	for recorded := range failures {
+		if recorded == name || strings.HasPrefix(recorded, name+"/") {
+			delete(failures, recorded)
+		}
+	}
+}
+
+// Recorder returns a value to pass to testify's assertions instead of t, to
+// record their failure messages.
+func Recorder(t *testing.T) *TestingT {
+	return &TestingT{t: t}
+}
+
+// TestingT implements the testing interface of testify, recording the
+// failure messages before reporting them to the wrapped *testing.T.
+type TestingT struct {
+	t *testing.T
+}
+
+// Errorf records the failure message of the test and calls t.Error.
+func (r *TestingT) Errorf(format string, args ...any) {
+	r.t.Helper()
+	Errorf(r.t, format, args...)
+}
+
+// FailNow calls t.FailNow.
+func (r *TestingT) FailNow() {
+	r.t.Helper()
+	r.t.FailNow()
+}
+
+// record records the failure message for the test and all its parents.
+func record(t *testing.T, msg string) {
+	lock.Lock()
+	defer lock.Unlock()
+	name := t.Name()
+	for {
+		failures[name] = msg
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			return
+		}
+		name = name[:i]
+	}
+}
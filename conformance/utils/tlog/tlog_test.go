@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlog
+
+import (
+	"testing"
+)
+
+func TestFailureMessage(t *testing.T) {
+	var parent, first, second string
+	t.Run("HTTPRouteSimpleSameNamespace", func(t *testing.T) {
+		parent = t.Name()
+		t.Run("first", func(t *testing.T) {
+			first = t.Name()
+			record(t, "first failure")
+		})
+		t.Run("second", func(t *testing.T) {
+			second = t.Name()
+			record(t, "second failure")
+		})
+	})
+
+	if got := FailureMessage(first); got != "first failure" {
+		t.Errorf("Expected the failure message of the subtest, got: %q", got)
+	}
+	if got := FailureMessage(parent); got != "second failure" {
+		t.Errorf("Expected the last failure message of the subtests, got: %q", got)
+	}
+
+	Reset(parent)
+	for _, name := range []string{parent, first, second} {
+		if got := FailureMessage(name); got != "" {
+			t.Errorf("Expected no failure message for %s after the reset, got: %q", name, got)
+		}
+	}
+	if got := FailureMessage(t.Name()); got != "second failure" {
+		t.Errorf("Expected the failure message of the parent not to be reset, got: %q", got)
+	}
+	Reset(t.Name())
+}
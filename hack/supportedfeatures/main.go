@@ -25,8 +25,8 @@ import (
 )
 
 func main() {
-	features := make([]string, suite.AllFeatures.Len())
-	for i, feat := range suite.AllFeatures.UnsortedList() {
+	features := make([]string, suite.KnownFeatures.Len())
+	for i, feat := range suite.KnownFeatures.UnsortedList() {
 		features[i] = string(feat)
 	}
 	sort.Strings(features)
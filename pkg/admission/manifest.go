@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is a single document of a YAML or JSON manifest file.
+type Manifest struct {
+	// File is the path of the file the document was read from.
+	File string
+	// Line is the line the document starts at.
+	Line int
+	// Raw is the JSON representation of the document.
+	Raw []byte
+
+	node *yaml.Node
+}
+
+// ReadManifests reads every document of the manifest file at path, expanding
+// the items of sequences and of list objects such as kind List. If path is a
+// directory, every .yaml, .yml and .json file below it is read. Files
+// that can't be read or decoded don't stop the others from being read, the
+// manifests of the other files are returned along with the errors of all the
+// failed files joined.
+func ReadManifests(path string) ([]Manifest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return readManifestFile(path)
+	}
+
+	var (
+		manifests []Manifest
+		fileErrs  []error
+	)
+	err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch filepath.Ext(file) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		m, err := readManifestFile(file)
+		if err != nil {
+			fileErrs = append(fileErrs, err)
+			return nil
+		}
+		manifests = append(manifests, m...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return manifests, errors.Join(fileErrs...)
+}
+
+func readManifestFile(file string) ([]Manifest, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var manifests []Manifest
+	decoder := yaml.NewDecoder(f)
+	for {
+		var node yaml.Node
+		err := decoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			return manifests, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if len(node.Content) == 0 {
+			continue
+		}
+		manifests, err = appendManifests(manifests, file, node.Content[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// appendManifests appends the objects of a document to manifests. Documents
+// that are a sequence, or a list object such as kind List, are expanded to
+// their items.
+func appendManifests(manifests []Manifest, file string, node *yaml.Node) ([]Manifest, error) {
+	if node.Tag == "!!null" {
+		return manifests, nil
+	}
+	items := listItems(node)
+	if items != nil {
+		var err error
+		for _, item := range items.Content {
+			manifests, err = appendManifests(manifests, file, item)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return manifests, nil
+	}
+
+	var obj any
+	if err := node.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("%s:%d: %w", file, node.Line, err)
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%d: %w", file, node.Line, err)
+	}
+	return append(manifests, Manifest{
+		File: file,
+		Line: node.Line,
+		Raw:  raw,
+		node: node,
+	}), nil
+}
+
+// listItems returns the sequence of items of a sequence node or of a list
+// object, whose kind ends with List, and nil for other nodes.
+func listItems(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.SequenceNode {
+		return node
+	}
+	_, kind := lookupNode(node, "kind")
+	_, items := lookupNode(node, "items")
+	if kind == nil || items == nil || items.Kind != yaml.SequenceNode || !strings.HasSuffix(kind.Value, "List") {
+		return nil
+	}
+	return items
+}
+
+// FieldLine returns the line of the field with the given path, as formatted
+// by field.Path. If the field does not exist in the document, the line of
+// the closest existing parent is returned.
+func (m *Manifest) FieldLine(path string) int {
+	if m.node == nil {
+		return m.Line
+	}
+	node, line := m.node, m.Line
+	for _, elem := range splitFieldPath(path) {
+		key, value := lookupNode(node, elem)
+		if value == nil {
+			break
+		}
+		node, line = value, key.Line
+	}
+	return line
+}
+
+// splitFieldPath splits a path such as "spec.rules[0].matches[1]" into its
+// elements, "spec", "rules", "0", "matches" and "1".
+func splitFieldPath(path string) []string {
+	var elems []string
+	for _, part := range strings.Split(path, ".") {
+		name, rest, _ := strings.Cut(part, "[")
+		if name != "" {
+			elems = append(elems, name)
+		}
+		for rest != "" {
+			var key string
+			key, rest, _ = strings.Cut(rest, "]")
+			elems = append(elems, key)
+			rest = strings.TrimPrefix(rest, "[")
+		}
+	}
+	return elems
+}
+
+// lookupNode returns the key and value nodes of the element of a mapping or
+// sequence node. For sequences the key is the element itself.
+func lookupNode(node *yaml.Node, elem string) (*yaml.Node, *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == elem {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		i, err := strconv.Atoi(elem)
+		if err == nil && i >= 0 && i < len(node.Content) {
+			return node.Content[i], node.Content[i]
+		}
+	}
+	return nil, nil
+}
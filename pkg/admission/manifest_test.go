@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `# leading comment
+apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+---
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: bad
+  namespace: default
+spec:
+  rules:
+  - filters:
+    - type: RequestRedirect
+      requestRedirect:
+        scheme: https
+    backendRefs:
+    - name: foo
+`
+
+func TestReadManifests(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "nested"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "routes.yaml"), []byte(testManifest), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gateway.json"), []byte(`{"apiVersion": "gateway.networking.k8s.io/v1", "kind": "Gateway"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# not a manifest"), 0o600))
+
+	manifests, err := ReadManifests(dir)
+	require.NoError(t, err)
+	require.Len(t, manifests, 3)
+
+	assert.Equal(t, filepath.Join(dir, "gateway.json"), manifests[0].File)
+	assert.Equal(t, 1, manifests[0].Line)
+	assert.Equal(t, filepath.Join(dir, "nested", "routes.yaml"), manifests[1].File)
+	assert.Equal(t, 2, manifests[1].Line)
+	assert.Equal(t, 8, manifests[2].Line)
+	assert.JSONEq(t, `{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "foo"}}`, string(manifests[1].Raw))
+
+	route := manifests[2]
+	for path, line := range map[string]int{
+		"":                                  8,
+		"spec":                              13,
+		"spec.rules[0].filters":             15,
+		"spec.rules[0].filters[0].type":     16,
+		"spec.rules[0].backendRefs[0]":      20,
+		"spec.rules[0].backendRefs[0].port": 20,
+		"spec.rules[3]":                     14,
+	} {
+		assert.Equal(t, line, route.FieldLine(path), path)
+	}
+}
+
+func TestReadManifestsInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("kind: [Gateway\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yaml"), []byte(testManifest), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.yaml"), []byte("kind: {Gateway\n"), 0o600))
+
+	manifests, err := ReadManifests(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), filepath.Join(dir, "a.yaml"))
+	assert.Contains(t, err.Error(), filepath.Join(dir, "c.yaml"))
+	require.Len(t, manifests, 2, "the files after an invalid file must still be read")
+	assert.Equal(t, filepath.Join(dir, "b.yaml"), manifests[0].File)
+}
+
+func TestReadManifestsList(t *testing.T) {
+	manifests, err := ReadManifests(writeManifest(t, `apiVersion: v1
+kind: List
+items:
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: Gateway
+  metadata:
+    name: foo
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    name: bar
+  spec:
+    hostnames: ["example.com"]
+`))
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	assert.Equal(t, 4, manifests[0].Line)
+	assert.JSONEq(t, `{"apiVersion": "gateway.networking.k8s.io/v1", "kind": "Gateway", "metadata": {"name": "foo"}}`, string(manifests[0].Raw))
+	assert.Equal(t, 8, manifests[1].Line)
+	assert.Equal(t, 13, manifests[1].FieldLine("spec.hostnames[0]"))
+}
+
+func TestReadManifestsSequence(t *testing.T) {
+	manifests, err := ReadManifests(writeManifest(t, `- apiVersion: gateway.networking.k8s.io/v1
+  kind: Gateway
+  metadata:
+    name: foo
+-
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRouteList
+  items:
+  - apiVersion: gateway.networking.k8s.io/v1
+    kind: HTTPRoute
+    metadata:
+      name: bar
+`))
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	assert.Equal(t, 1, manifests[0].Line)
+	assert.JSONEq(t, `{"apiVersion": "gateway.networking.k8s.io/v1", "kind": "Gateway", "metadata": {"name": "foo"}}`, string(manifests[0].Raw))
+	assert.Equal(t, 9, manifests[1].Line)
+	assert.JSONEq(t, `{"apiVersion": "gateway.networking.k8s.io/v1", "kind": "HTTPRoute", "metadata": {"name": "bar"}}`, string(manifests[1].Raw))
+}
+
+func TestValidateObject(t *testing.T) {
+	manifests, err := ReadManifests(writeManifest(t, testManifest))
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	_, _, err = ValidateObject(manifests[0].Raw)
+	assert.ErrorIs(t, err, ErrUnsupportedKind)
+
+	errs, warnings, err := ValidateObject(manifests[1].Raw)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, `[spec.rules[0].filters: Invalid value: "RequestRedirect": RequestRedirect filter is not allowed with backendRefs, `+
+		`spec.rules[0].backendRefs[0].port: Required value: missing port for Service reference]`, errs.ToAggregate().Error())
+}
+
+func writeManifest(t *testing.T, content string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	return file
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	admission "k8s.io/api/admission/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "gateway_api_admission"
+
+// Result label values of the admission requests counter.
+const (
+	resultAllowed = "allowed"
+	resultDenied  = "denied"
+	resultError   = "error"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "requests_total",
+		Help:      "Total number of admission requests by resource, operation and result (allowed, denied or error).",
+	}, []string{"group", "version", "resource", "operation", "result"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of admission request validation by resource and operation.",
+		Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1},
+	}, []string{"group", "version", "resource", "operation"})
+
+	decodeFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "decode_failures_total",
+		Help:      "Total number of admission requests whose payload could not be decoded. Malformed AdmissionReviews are reported with empty resource labels.",
+	}, []string{"group", "version", "resource"})
+
+	certificateExpiry = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "tls_certificate_expiration_timestamp_seconds",
+		Help:      "Expiration time of the serving TLS certificate as a Unix timestamp.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestsTotal,
+		requestDuration,
+		decodeFailuresTotal,
+		certificateExpiry,
+	)
+}
+
+// MetricsHandler returns an http.Handler serving the admission webhook
+// metrics in the Prometheus exposition format. Metrics registered with the
+// controller-runtime registry, such as those of the certificate watcher, are
+// served as well.
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(prometheus.Gatherers{registry, ctrlmetrics.Registry}, promhttp.HandlerOpts{})
+}
+
+// ObserveCertificate records the expiry of the serving certificate. It is
+// meant to be registered as a callback invoked every time the certificate is
+// (re)loaded.
+func ObserveCertificate(cert tls.Certificate) {
+	leaf := cert.Leaf
+	if leaf == nil {
+		if len(cert.Certificate) == 0 {
+			return
+		}
+		var err error
+		leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			klog.Errorf("failed to parse serving certificate: %v", err)
+			return
+		}
+	}
+	certificateExpiry.Set(float64(leaf.NotAfter.Unix()))
+	klog.Infof("loaded serving certificate valid until %s", leaf.NotAfter.Format(time.RFC3339))
+}
+
+func recordDecodeFailure(resource meta.GroupVersionResource) {
+	decodeFailuresTotal.WithLabelValues(resource.Group, resource.Version, resource.Resource).Inc()
+}
+
+func recordRequest(request *admission.AdmissionRequest, response *admission.AdmissionResponse, start time.Time) {
+	result := resultError
+	if response != nil {
+		result = resultDenied
+		if response.Allowed {
+			result = resultAllowed
+		}
+	}
+	resource := request.Resource
+	operation := string(request.Operation)
+	requestsTotal.WithLabelValues(resource.Group, resource.Version, resource.Resource, operation, result).Inc()
+	requestDuration.WithLabelValues(resource.Group, resource.Version, resource.Resource, operation).
+		Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy implements organization specific guardrails that the
+// admission webhook enforces in addition to the Gateway API specification.
+// Rules are declared in a configuration file, for example:
+//
+//	rules:
+//	- name: team-a-gateways
+//	  namespaces: ["team-a"]
+//	  allowedGatewayClasses: ["internal"]
+//	  allowedListenerPorts: [80, 443]
+//	  hostnameSuffix: "{namespace}.example.com"
+//	- name: no-mirroring
+//	  enforcement: Warn
+//	  forbiddenHTTPRouteFilters: ["RequestMirror"]
+//	  forbiddenGRPCRouteFilters: ["RequestMirror"]
+package policy
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// NamespacePlaceholder is replaced by the namespace of the validated object
+// in Rule.HostnameSuffix.
+const NamespacePlaceholder = "{namespace}"
+
+// Enforcement defines what happens when an object violates a rule.
+type Enforcement string
+
+const (
+	// EnforcementDeny rejects objects violating the rule.
+	EnforcementDeny Enforcement = "Deny"
+	// EnforcementWarn admits objects violating the rule and returns a
+	// warning to the client.
+	EnforcementWarn Enforcement = "Warn"
+)
+
+// Config is the format of the policy configuration file.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule is a set of constraints enforced on Gateway API objects. Every
+// constraint that is set must be satisfied.
+type Rule struct {
+	// Name identifies the rule in error messages and warnings.
+	Name string `json:"name"`
+
+	// Enforcement is the action taken on violations. Defaults to Deny.
+	Enforcement Enforcement `json:"enforcement,omitempty"`
+
+	// Namespaces limits the rule to objects in the listed namespaces. The
+	// rule applies to all namespaces when empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// AllowedGatewayClasses is the list of GatewayClasses Gateways may use.
+	AllowedGatewayClasses []gatewayv1.ObjectName `json:"allowedGatewayClasses,omitempty"`
+
+	// AllowedListenerPorts is the list of ports Gateway listeners may use.
+	AllowedListenerPorts []gatewayv1.PortNumber `json:"allowedListenerPorts,omitempty"`
+
+	// HostnameSuffix requires listener and route hostnames to be equal to,
+	// or a subdomain of, the suffix. NamespacePlaceholder is replaced by
+	// the namespace of the object. Listeners and routes without hostnames
+	// match any host and are therefore rejected.
+	HostnameSuffix string `json:"hostnameSuffix,omitempty"`
+
+	// ForbiddenHTTPRouteFilters is the list of filter types HTTPRoutes may
+	// not use, either on rules or on backendRefs. It doesn't apply to
+	// GRPCRoutes, see ForbiddenGRPCRouteFilters.
+	ForbiddenHTTPRouteFilters []gatewayv1.HTTPRouteFilterType `json:"forbiddenHTTPRouteFilters,omitempty"`
+
+	// ForbiddenGRPCRouteFilters is the list of filter types GRPCRoutes may
+	// not use, either on rules or on backendRefs.
+	ForbiddenGRPCRouteFilters []gatewayv1a2.GRPCRouteFilterType `json:"forbiddenGRPCRouteFilters,omitempty"`
+}
+
+// Result is the outcome of evaluating a Policy against an object.
+type Result struct {
+	// Errors are violations of rules enforced with EnforcementDeny.
+	Errors field.ErrorList
+	// Warnings are violations of rules enforced with EnforcementWarn.
+	Warnings []string
+}
+
+func (r *Result) add(rule *Rule, errs field.ErrorList) {
+	for _, err := range errs {
+		err.Detail = fmt.Sprintf("%s (policy rule %q)", err.Detail, rule.Name)
+		if rule.Enforcement == EnforcementWarn {
+			r.Warnings = append(r.Warnings, err.Error())
+		} else {
+			r.Errors = append(r.Errors, err)
+		}
+	}
+}
+
+// Policy is a validated set of rules. The zero value and nil both accept
+// every object.
+type Policy struct {
+	rules []Rule
+}
+
+// Load reads and parses the policy configuration file at path.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates a policy configuration.
+func Parse(data []byte) (*Policy, error) {
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse policy configuration: %w", err)
+	}
+	if errs := validateConfig(&config); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid policy configuration: %w", errs.ToAggregate())
+	}
+	return &Policy{rules: config.Rules}, nil
+}
+
+func validateConfig(config *Config) field.ErrorList {
+	var errs field.ErrorList
+	names := sets.New[string]()
+	for i := range config.Rules {
+		rule := &config.Rules[i]
+		path := field.NewPath("rules").Index(i)
+		if rule.Name == "" {
+			errs = append(errs, field.Required(path.Child("name"), "must be specified"))
+		} else if names.Has(rule.Name) {
+			errs = append(errs, field.Duplicate(path.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		switch rule.Enforcement {
+		case "":
+			rule.Enforcement = EnforcementDeny
+		case EnforcementDeny, EnforcementWarn:
+		default:
+			errs = append(errs, field.NotSupported(path.Child("enforcement"), rule.Enforcement,
+				[]string{string(EnforcementDeny), string(EnforcementWarn)}))
+		}
+
+		for j, port := range rule.AllowedListenerPorts {
+			if port < 1 || port > 65535 {
+				errs = append(errs, field.Invalid(path.Child("allowedListenerPorts").Index(j), port, "must be between 1 and 65535"))
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateGateway evaluates the policy against a Gateway.
+func (p *Policy) ValidateGateway(gw *gatewayv1.Gateway) Result {
+	var result Result
+	for _, rule := range p.applicableRules(gw.Namespace) {
+		var errs field.ErrorList
+		path := field.NewPath("spec")
+		if len(rule.AllowedGatewayClasses) > 0 && !slices.Contains(rule.AllowedGatewayClasses, gw.Spec.GatewayClassName) {
+			errs = append(errs, field.NotSupported(path.Child("gatewayClassName"), gw.Spec.GatewayClassName, toStrings(rule.AllowedGatewayClasses)))
+		}
+		for j, listener := range gw.Spec.Listeners {
+			listenerPath := path.Child("listeners").Index(j)
+			if len(rule.AllowedListenerPorts) > 0 && !slices.Contains(rule.AllowedListenerPorts, listener.Port) {
+				errs = append(errs, field.NotSupported(listenerPath.Child("port"), listener.Port, toStrings(rule.AllowedListenerPorts)))
+			}
+			if rule.HostnameSuffix != "" {
+				suffix := rule.hostnameSuffix(gw.Namespace)
+				if listener.Hostname == nil {
+					errs = append(errs, field.Required(listenerPath.Child("hostname"), fmt.Sprintf("must be %q or one of its subdomains", suffix)))
+				} else if !hasHostnameSuffix(*listener.Hostname, suffix) {
+					errs = append(errs, field.Invalid(listenerPath.Child("hostname"), *listener.Hostname, fmt.Sprintf("must be %q or one of its subdomains", suffix)))
+				}
+			}
+		}
+		result.add(rule, errs)
+	}
+	return result
+}
+
+// ValidateHTTPRoute evaluates the policy against an HTTPRoute.
+func (p *Policy) ValidateHTTPRoute(route *gatewayv1.HTTPRoute) Result {
+	var result Result
+	for _, rule := range p.applicableRules(route.Namespace) {
+		var errs field.ErrorList
+		path := field.NewPath("spec")
+		if rule.HostnameSuffix != "" {
+			errs = append(errs, validateHostnames(rule, route.Namespace, route.Spec.Hostnames, path.Child("hostnames"))...)
+		}
+		if len(rule.ForbiddenHTTPRouteFilters) > 0 {
+			for j, r := range route.Spec.Rules {
+				rulePath := path.Child("rules").Index(j)
+				errs = append(errs, validateForbiddenHTTPRouteFilters(rule, r.Filters, rulePath.Child("filters"))...)
+				for k, backendRef := range r.BackendRefs {
+					errs = append(errs, validateForbiddenHTTPRouteFilters(rule, backendRef.Filters, rulePath.Child("backendRefs").Index(k).Child("filters"))...)
+				}
+			}
+		}
+		result.add(rule, errs)
+	}
+	return result
+}
+
+// ValidateGRPCRoute evaluates the policy against a GRPCRoute.
+func (p *Policy) ValidateGRPCRoute(route *gatewayv1a2.GRPCRoute) Result {
+	var result Result
+	for _, rule := range p.applicableRules(route.Namespace) {
+		var errs field.ErrorList
+		path := field.NewPath("spec")
+		if rule.HostnameSuffix != "" {
+			errs = append(errs, validateHostnames(rule, route.Namespace, route.Spec.Hostnames, path.Child("hostnames"))...)
+		}
+		if len(rule.ForbiddenGRPCRouteFilters) > 0 {
+			for j, r := range route.Spec.Rules {
+				rulePath := path.Child("rules").Index(j)
+				errs = append(errs, validateForbiddenGRPCRouteFilters(rule, r.Filters, rulePath.Child("filters"))...)
+				for k, backendRef := range r.BackendRefs {
+					errs = append(errs, validateForbiddenGRPCRouteFilters(rule, backendRef.Filters, rulePath.Child("backendRefs").Index(k).Child("filters"))...)
+				}
+			}
+		}
+		result.add(rule, errs)
+	}
+	return result
+}
+
+// ValidateTLSRoute evaluates the policy against a TLSRoute.
+func (p *Policy) ValidateTLSRoute(route *gatewayv1a2.TLSRoute) Result {
+	var result Result
+	for _, rule := range p.applicableRules(route.Namespace) {
+		if rule.HostnameSuffix != "" {
+			result.add(rule, validateHostnames(rule, route.Namespace, route.Spec.Hostnames, field.NewPath("spec", "hostnames")))
+		}
+	}
+	return result
+}
+
+// applicableRules returns the rules that apply to objects in namespace.
+func (p *Policy) applicableRules(namespace string) []*Rule {
+	if p == nil {
+		return nil
+	}
+	var rules []*Rule
+	for i := range p.rules {
+		rule := &p.rules[i]
+		if len(rule.Namespaces) == 0 || slices.Contains(rule.Namespaces, namespace) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func (r *Rule) hostnameSuffix(namespace string) string {
+	return strings.ReplaceAll(r.HostnameSuffix, NamespacePlaceholder, namespace)
+}
+
+func hasHostnameSuffix(hostname gatewayv1.Hostname, suffix string) bool {
+	return string(hostname) == suffix || strings.HasSuffix(string(hostname), "."+suffix)
+}
+
+func validateHostnames(rule *Rule, namespace string, hostnames []gatewayv1.Hostname, path *field.Path) field.ErrorList {
+	suffix := rule.hostnameSuffix(namespace)
+	if len(hostnames) == 0 {
+		return field.ErrorList{field.Required(path, fmt.Sprintf("must be restricted to %q or its subdomains", suffix))}
+	}
+	var errs field.ErrorList
+	for i, hostname := range hostnames {
+		if !hasHostnameSuffix(hostname, suffix) {
+			errs = append(errs, field.Invalid(path.Index(i), hostname, fmt.Sprintf("must be %q or one of its subdomains", suffix)))
+		}
+	}
+	return errs
+}
+
+func validateForbiddenHTTPRouteFilters(rule *Rule, filters []gatewayv1.HTTPRouteFilter, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, filter := range filters {
+		if slices.Contains(rule.ForbiddenHTTPRouteFilters, filter.Type) {
+			errs = append(errs, field.Forbidden(path.Index(i).Child("type"), fmt.Sprintf("%s filters are not allowed", filter.Type)))
+		}
+	}
+	return errs
+}
+
+func validateForbiddenGRPCRouteFilters(rule *Rule, filters []gatewayv1a2.GRPCRouteFilter, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, filter := range filters {
+		if slices.Contains(rule.ForbiddenGRPCRouteFilters, filter.Type) {
+			errs = append(errs, field.Forbidden(path.Index(i).Child("type"), fmt.Sprintf("%s filters are not allowed", filter.Type)))
+		}
+	}
+	return errs
+}
+
+func toStrings[T ~string | ~int32](values []T) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, fmt.Sprint(v))
+	}
+	return out
+}
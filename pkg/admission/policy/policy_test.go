@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const testConfig = `
+rules:
+- name: team-a-gateways
+  namespaces: ["team-a"]
+  allowedGatewayClasses: ["internal"]
+  allowedListenerPorts: [80, 443]
+  hostnameSuffix: "{namespace}.example.com"
+- name: no-mirroring
+  enforcement: Warn
+  forbiddenHTTPRouteFilters: ["RequestMirror"]
+  forbiddenGRPCRouteFilters: ["RequestMirror"]
+`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{{
+		name:   "valid configuration",
+		config: testConfig,
+	}, {
+		name:   "empty configuration",
+		config: "",
+	}, {
+		name:    "unknown field",
+		config:  "rules:\n- name: foo\n  allowedPorts: [80]\n",
+		wantErr: `failed to parse policy configuration: error unmarshaling JSON: while decoding JSON: json: unknown field "allowedPorts"`,
+	}, {
+		name:    "missing name",
+		config:  "rules:\n- enforcement: Warn\n",
+		wantErr: "invalid policy configuration: rules[0].name: Required value: must be specified",
+	}, {
+		name:    "duplicate name",
+		config:  "rules:\n- name: foo\n- name: foo\n",
+		wantErr: `invalid policy configuration: rules[1].name: Duplicate value: "foo"`,
+	}, {
+		name:    "unsupported enforcement",
+		config:  "rules:\n- name: foo\n  enforcement: Audit\n",
+		wantErr: `invalid policy configuration: rules[0].enforcement: Unsupported value: "Audit": supported values: "Deny", "Warn"`,
+	}, {
+		name:    "invalid port",
+		config:  "rules:\n- name: foo\n  allowedListenerPorts: [0]\n",
+		wantErr: "invalid policy configuration: rules[0].allowedListenerPorts[0]: Invalid value: 0: must be between 1 and 65535",
+	}, {
+		name:    "port out of range",
+		config:  "rules:\n- name: foo\n  allowedListenerPorts: [80, 65536]\n",
+		wantErr: "invalid policy configuration: rules[0].allowedListenerPorts[1]: Invalid value: 65536: must be between 1 and 65535",
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.config))
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGateway(t *testing.T) {
+	p, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		gateway    *gatewayv1.Gateway
+		wantErrors []string
+	}{{
+		name:    "compliant gateway",
+		gateway: gateway("team-a", "internal", listener(443, "app.team-a.example.com")),
+	}, {
+		name:    "rule does not apply to other namespaces",
+		gateway: gateway("team-b", "public", listener(8080, "")),
+	}, {
+		name:    "disallowed gateway class",
+		gateway: gateway("team-a", "public", listener(80, "team-a.example.com")),
+		wantErrors: []string{
+			`spec.gatewayClassName: Unsupported value: "public": supported values: "internal" (policy rule "team-a-gateways")`,
+		},
+	}, {
+		name:    "disallowed port and hostnames",
+		gateway: gateway("team-a", "internal", listener(8080, "app.team-b.example.com"), listener(80, "")),
+		wantErrors: []string{
+			`spec.listeners[0].port: Unsupported value: 8080: supported values: "80", "443" (policy rule "team-a-gateways")`,
+			`spec.listeners[0].hostname: Invalid value: "app.team-b.example.com": must be "team-a.example.com" or one of its subdomains (policy rule "team-a-gateways")`,
+			`spec.listeners[1].hostname: Required value: must be "team-a.example.com" or one of its subdomains (policy rule "team-a-gateways")`,
+		},
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := p.ValidateGateway(tc.gateway)
+			assert.Empty(t, result.Warnings)
+			assert.Equal(t, tc.wantErrors, errorStrings(result))
+		})
+	}
+}
+
+func TestValidateHTTPRoute(t *testing.T) {
+	p, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	mirror := gatewayv1.HTTPRouteFilter{
+		Type:          gatewayv1.HTTPRouteFilterRequestMirror,
+		RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{},
+	}
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+		Spec: gatewayv1.HTTPRouteSpec{
+			Hostnames: []gatewayv1.Hostname{"team-a.example.com", "example.com"},
+			Rules: []gatewayv1.HTTPRouteRule{{
+				Filters: []gatewayv1.HTTPRouteFilter{mirror},
+				BackendRefs: []gatewayv1.HTTPBackendRef{{
+					Filters: []gatewayv1.HTTPRouteFilter{mirror},
+				}},
+			}},
+		},
+	}
+
+	result := p.ValidateHTTPRoute(route)
+	assert.Equal(t, []string{
+		`spec.hostnames[1]: Invalid value: "example.com": must be "team-a.example.com" or one of its subdomains (policy rule "team-a-gateways")`,
+	}, errorStrings(result))
+	assert.Equal(t, []string{
+		`spec.rules[0].filters[0].type: Forbidden: RequestMirror filters are not allowed (policy rule "no-mirroring")`,
+		`spec.rules[0].backendRefs[0].filters[0].type: Forbidden: RequestMirror filters are not allowed (policy rule "no-mirroring")`,
+	}, result.Warnings)
+}
+
+func TestValidateGRPCRoute(t *testing.T) {
+	p, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	mirror := gatewayv1a2.GRPCRouteFilter{
+		Type:          gatewayv1a2.GRPCRouteFilterRequestMirror,
+		RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{},
+	}
+	route := &gatewayv1a2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+		Spec: gatewayv1a2.GRPCRouteSpec{
+			Hostnames: []gatewayv1a2.Hostname{"grpc.team-a.example.com"},
+			Rules: []gatewayv1a2.GRPCRouteRule{{
+				Filters: []gatewayv1a2.GRPCRouteFilter{mirror},
+				BackendRefs: []gatewayv1a2.GRPCBackendRef{{
+					Filters: []gatewayv1a2.GRPCRouteFilter{mirror},
+				}},
+			}},
+		},
+	}
+
+	result := p.ValidateGRPCRoute(route)
+	assert.Empty(t, errorStrings(result))
+	assert.Equal(t, []string{
+		`spec.rules[0].filters[0].type: Forbidden: RequestMirror filters are not allowed (policy rule "no-mirroring")`,
+		`spec.rules[0].backendRefs[0].filters[0].type: Forbidden: RequestMirror filters are not allowed (policy rule "no-mirroring")`,
+	}, result.Warnings)
+}
+
+func TestValidateRouteHostnames(t *testing.T) {
+	p, err := Parse([]byte(testConfig))
+	require.NoError(t, err)
+
+	grpcRoute := &gatewayv1a2.GRPCRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"}}
+	assert.Equal(t, []string{
+		`spec.hostnames: Required value: must be restricted to "team-a.example.com" or its subdomains (policy rule "team-a-gateways")`,
+	}, errorStrings(p.ValidateGRPCRoute(grpcRoute)))
+
+	tlsRoute := &gatewayv1a2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+		Spec:       gatewayv1a2.TLSRouteSpec{Hostnames: []gatewayv1a2.Hostname{"*.team-a.example.com"}},
+	}
+	assert.Empty(t, errorStrings(p.ValidateTLSRoute(tlsRoute)))
+}
+
+func TestNilPolicy(t *testing.T) {
+	var p *Policy
+	assert.Equal(t, Result{}, p.ValidateGateway(gateway("team-a", "public")))
+}
+
+func gateway(namespace string, className gatewayv1.ObjectName, listeners ...gatewayv1.Listener) *gatewayv1.Gateway {
+	return &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: className,
+			Listeners:        listeners,
+		},
+	}
+}
+
+func listener(port gatewayv1.PortNumber, hostname gatewayv1.Hostname) gatewayv1.Listener {
+	l := gatewayv1.Listener{Port: port}
+	if hostname != "" {
+		l.Hostname = &hostname
+	}
+	return l
+}
+
+func errorStrings(result Result) []string {
+	var out []string
+	for _, err := range result.Errors {
+		out = append(out, err.Error())
+	}
+	return out
+}
@@ -20,9 +20,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"time"
 
 	admission "k8s.io/api/admission/v1"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -35,6 +38,7 @@ import (
 	v1a2Validation "sigs.k8s.io/gateway-api/apis/v1alpha2/validation"
 	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	v1b1Validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
+	"sigs.k8s.io/gateway-api/pkg/admission/policy"
 )
 
 const admissionReview = "AdmissionReview"
@@ -97,13 +101,39 @@ var (
 	}
 )
 
+// admissionPolicy holds the policy rules evaluated after the Gateway API
+// validation succeeds.
+var admissionPolicy *policy.Policy
+
+// SetPolicy configures the policy rules enforced in addition to the Gateway
+// API validation. It must be called before the server starts handling
+// requests. A nil policy disables policy enforcement.
+func SetPolicy(p *policy.Policy) {
+	admissionPolicy = p
+}
+
+// validateRegularExpressions enables the RE2 checks of the regular
+// expressions used by HTTPRoute matches.
+var validateRegularExpressions bool
+
+// SetRegularExpressionValidation enables or disables the validation of the
+// regular expressions used by HTTPRoute path, header and query param matches.
+// Invalid expressions are rejected and expressions that are likely ambiguous
+// or expensive result in warnings. It must be called before the server
+// starts handling requests.
+func SetRegularExpressionValidation(enabled bool) {
+	validateRegularExpressions = enabled
+}
+
 func log500(w http.ResponseWriter, err error) {
 	klog.Errorf("failed to process request: %v\n", err)
 	http.Error(w, err.Error(), http.StatusInternalServerError)
 }
 
 // ServeHTTP parses AdmissionReview requests and responds back
-// with the validation result of the entity.
+// with the validation result of the entity. Both admission.k8s.io/v1 and
+// admission.k8s.io/v1beta1 reviews are accepted, and the response is sent
+// in the same version as the request.
 func ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		w.WriteHeader(http.StatusMethodNotAllowed)
@@ -111,6 +141,15 @@ func ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || mediaType != runtime.ContentTypeJSON {
+			http.Error(w, fmt.Sprintf("unsupported content type %s, only %s is supported", contentType, runtime.ContentTypeJSON),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+	}
+
 	if r.Body == nil {
 		http.Error(w, "admission review object is missing",
 			http.StatusBadRequest)
@@ -122,29 +161,69 @@ func ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	review := admission.AdmissionReview{}
-	err = json.Unmarshal(data, &review)
+	var typeMeta meta.TypeMeta
+	err = json.Unmarshal(data, &typeMeta)
 	if err != nil {
+		recordDecodeFailure(meta.GroupVersionResource{})
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	if review.Kind != admissionReview {
+	if typeMeta.Kind != admissionReview {
 		http.Error(w, "submitted object is not of kind AdmissionReview", http.StatusBadRequest)
 		return
 	}
 
-	response, err := handleValidation(*review.Request)
-	if err != nil {
-		log500(w, err)
+	var review any
+	switch typeMeta.APIVersion {
+	case admission.SchemeGroupVersion.String():
+		v1Review := admission.AdmissionReview{}
+		err = json.Unmarshal(data, &v1Review)
+		if err != nil {
+			recordDecodeFailure(meta.GroupVersionResource{})
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if v1Review.Request == nil {
+			http.Error(w, "admission review request is missing", http.StatusBadRequest)
+			return
+		}
+		v1Review.Response, err = validate(v1Review.Request)
+		if err != nil {
+			log500(w, err)
+			return
+		}
+		review = v1Review
+	case admissionv1beta1.SchemeGroupVersion.String():
+		v1beta1Review := admissionv1beta1.AdmissionReview{}
+		err = json.Unmarshal(data, &v1beta1Review)
+		if err != nil {
+			recordDecodeFailure(meta.GroupVersionResource{})
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if v1beta1Review.Request == nil {
+			http.Error(w, "admission review request is missing", http.StatusBadRequest)
+			return
+		}
+		response, err := validate(convertRequestFromV1beta1(v1beta1Review.Request))
+		if err != nil {
+			log500(w, err)
+			return
+		}
+		v1beta1Review.Response = convertResponseToV1beta1(response)
+		review = v1beta1Review
+	default:
+		http.Error(w, fmt.Sprintf("unsupported AdmissionReview version %s", typeMeta.APIVersion), http.StatusBadRequest)
 		return
 	}
-	review.Response = response
+
 	data, err = json.Marshal(review)
 	if err != nil {
 		log500(w, err)
 		return
 	}
+	w.Header().Set("Content-Type", runtime.ContentTypeJSON)
 	_, err = w.Write(data)
 	if err != nil {
 		klog.Errorf("failed to write HTTP response: %v\n", err)
@@ -152,12 +231,51 @@ func ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func validate(request *admission.AdmissionRequest) (*admission.AdmissionResponse, error) {
+	start := time.Now()
+	response, err := handleValidation(*request)
+	recordRequest(request, response, start)
+	return response, err
+}
+
+func convertRequestFromV1beta1(in *admissionv1beta1.AdmissionRequest) *admission.AdmissionRequest {
+	return &admission.AdmissionRequest{
+		UID:                in.UID,
+		Kind:               in.Kind,
+		Resource:           in.Resource,
+		SubResource:        in.SubResource,
+		RequestKind:        in.RequestKind,
+		RequestResource:    in.RequestResource,
+		RequestSubResource: in.RequestSubResource,
+		Name:               in.Name,
+		Namespace:          in.Namespace,
+		Operation:          admission.Operation(in.Operation),
+		UserInfo:           in.UserInfo,
+		Object:             in.Object,
+		OldObject:          in.OldObject,
+		DryRun:             in.DryRun,
+		Options:            in.Options,
+	}
+}
+
+func convertResponseToV1beta1(in *admission.AdmissionResponse) *admissionv1beta1.AdmissionResponse {
+	out := &admissionv1beta1.AdmissionResponse{
+		UID:              in.UID,
+		Allowed:          in.Allowed,
+		Result:           in.Result,
+		Patch:            in.Patch,
+		AuditAnnotations: in.AuditAnnotations,
+		Warnings:         in.Warnings,
+	}
+	if in.PatchType != nil {
+		patchType := admissionv1beta1.PatchType(*in.PatchType)
+		out.PatchType = &patchType
+	}
+	return out
+}
+
 func handleValidation(request admission.AdmissionRequest) (*admission.AdmissionResponse, error) {
-	var (
-		response     admission.AdmissionResponse
-		deserializer = codecs.UniversalDeserializer()
-		fieldErr     field.ErrorList
-	)
+	var response admission.AdmissionResponse
 
 	if request.Operation == admission.Delete ||
 		request.Operation == admission.Connect {
@@ -166,115 +284,173 @@ func handleValidation(request admission.AdmissionRequest) (*admission.AdmissionR
 		return &response, nil
 	}
 
+	fieldErr, warnings, err := validateRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldErr) > 0 {
+		return &admission.AdmissionResponse{
+			UID:     request.UID,
+			Allowed: false,
+			Result: &meta.Status{
+				Message: fmt.Sprintf("%s", fieldErr.ToAggregate()),
+				Code:    400,
+			},
+			Warnings: warnings,
+		}, nil
+	}
+
+	return &admission.AdmissionResponse{
+		UID:      request.UID,
+		Allowed:  true,
+		Result:   &meta.Status{},
+		Warnings: warnings,
+	}, nil
+}
+
+// validateRequest validates the object of the request according to its
+// resource, and returns the validation errors together with the warnings
+// of the validation and of the policy rules.
+func validateRequest(request admission.AdmissionRequest) (field.ErrorList, []string, error) {
+	var (
+		deserializer = codecs.UniversalDeserializer()
+		fieldErr     field.ErrorList
+		warnings     []string
+		policyResult policy.Result
+	)
+
+	decode := func(raw []byte, into runtime.Object) error {
+		_, _, err := deserializer.Decode(raw, nil, into)
+		if err != nil {
+			recordDecodeFailure(request.Resource)
+			return err
+		}
+		// Policy rules may be scoped to namespaces, make sure the namespace
+		// of the request is known even if the object omits it.
+		if obj, ok := into.(meta.Object); ok && obj.GetNamespace() == "" {
+			obj.SetNamespace(request.Namespace)
+		}
+		return nil
+	}
+
 	switch request.Resource {
 	case v1a2TCPRouteGVP:
 		var tRoute v1alpha2.TCPRoute
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &tRoute)
+		err := decode(request.Object.Raw, &tRoute)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fieldErr = v1a2Validation.ValidateTCPRoute(&tRoute)
 	case v1a2UDPRouteGVP:
 		var uRoute v1alpha2.UDPRoute
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &uRoute)
+		err := decode(request.Object.Raw, &uRoute)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fieldErr = v1a2Validation.ValidateUDPRoute(&uRoute)
 	case v1a2TLSRouteGVP:
 		var tRoute v1alpha2.TLSRoute
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &tRoute)
+		err := decode(request.Object.Raw, &tRoute)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fieldErr = v1a2Validation.ValidateTLSRoute(&tRoute)
+		policyResult = admissionPolicy.ValidateTLSRoute(&tRoute)
 	case v1a2GRPCRouteGVR:
 		var gRoute v1alpha2.GRPCRoute
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gRoute)
+		err := decode(request.Object.Raw, &gRoute)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		fieldErr = v1a2Validation.ValidateGRPCRoute(&gRoute)
+		policyResult = admissionPolicy.ValidateGRPCRoute(&gRoute)
 	case v1b1HTTPRouteGVR:
 		var hRoute v1beta1.HTTPRoute
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &hRoute)
+		err := decode(request.Object.Raw, &hRoute)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		fieldErr = v1b1Validation.ValidateHTTPRoute(&hRoute)
+		if validateRegularExpressions {
+			regexErr, regexWarnings := v1b1Validation.ValidateHTTPRouteRegularExpressions(&hRoute)
+			fieldErr = append(fieldErr, regexErr...)
+			warnings = regexWarnings
+		}
+		policyResult = admissionPolicy.ValidateHTTPRoute((*v1.HTTPRoute)(&hRoute))
 	case v1b1GatewayGVR:
 		var gateway v1beta1.Gateway
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gateway)
+		err := decode(request.Object.Raw, &gateway)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fieldErr = v1b1Validation.ValidateGateway(&gateway)
+		warnings = v1b1Validation.ValidateGatewayListenerConflicts(&gateway)
+		policyResult = admissionPolicy.ValidateGateway((*v1.Gateway)(&gateway))
 	case v1b1GatewayClassGVR:
 		// runs only for updates
 		if request.Operation != admission.Update {
 			break
 		}
 		var gatewayClass v1beta1.GatewayClass
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gatewayClass)
+		err := decode(request.Object.Raw, &gatewayClass)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		var gatewayClassOld v1beta1.GatewayClass
-		_, _, err = deserializer.Decode(request.OldObject.Raw, nil, &gatewayClassOld)
+		err = decode(request.OldObject.Raw, &gatewayClassOld)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fieldErr = v1b1Validation.ValidateGatewayClassUpdate(&gatewayClassOld, &gatewayClass)
 	case v1HTTPRouteGVR:
 		var hRoute v1.HTTPRoute
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &hRoute)
+		err := decode(request.Object.Raw, &hRoute)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fieldErr = v1Validation.ValidateHTTPRoute(&hRoute)
+		if validateRegularExpressions {
+			regexErr, regexWarnings := v1Validation.ValidateHTTPRouteRegularExpressions(&hRoute)
+			fieldErr = append(fieldErr, regexErr...)
+			warnings = regexWarnings
+		}
+		policyResult = admissionPolicy.ValidateHTTPRoute(&hRoute)
 	case v1GatewayGVR:
 		var gateway v1.Gateway
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gateway)
+		err := decode(request.Object.Raw, &gateway)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fieldErr = v1Validation.ValidateGateway(&gateway)
+		warnings = v1Validation.ValidateGatewayListenerConflicts(&gateway)
+		policyResult = admissionPolicy.ValidateGateway(&gateway)
 	case v1GatewayClassGVR:
 		// runs only for updates
 		if request.Operation != admission.Update {
 			break
 		}
 		var gatewayClass v1.GatewayClass
-		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gatewayClass)
+		err := decode(request.Object.Raw, &gatewayClass)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		var gatewayClassOld v1.GatewayClass
-		_, _, err = deserializer.Decode(request.OldObject.Raw, nil, &gatewayClassOld)
+		err = decode(request.OldObject.Raw, &gatewayClassOld)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fieldErr = v1Validation.ValidateGatewayClassUpdate(&gatewayClassOld, &gatewayClass)
 	default:
-		return nil, fmt.Errorf("unknown resource '%v'", request.Resource.Resource)
+		return nil, nil, fmt.Errorf("unknown resource '%v'", request.Resource.Resource)
 	}
 
-	if len(fieldErr) > 0 {
-		return &admission.AdmissionResponse{
-			UID:     request.UID,
-			Allowed: false,
-			Result: &meta.Status{
-				Message: fmt.Sprintf("%s", fieldErr.ToAggregate()),
-				Code:    400,
-			},
-		}, nil
+	// Policy rules are only reported for objects that are otherwise valid.
+	if len(fieldErr) == 0 {
+		fieldErr = policyResult.Errors
+		warnings = append(warnings, policyResult.Warnings...)
 	}
-
-	return &admission.AdmissionResponse{
-		UID:     request.UID,
-		Allowed: true,
-		Result:  &meta.Status{},
-	}, nil
+	return fieldErr, warnings, nil
 }
@@ -25,10 +25,13 @@ import (
 	"testing"
 
 	"github.com/lithammer/dedent"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	admission "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/gateway-api/pkg/admission/policy"
 )
 
 var decoder = codecs.UniversalDeserializer()
@@ -59,10 +62,35 @@ func TestServeHTTPInvalidMethod(t *testing.T) {
 		res.Body.String())
 }
 
+func TestServeHTTPInvalidContentType(t *testing.T) {
+	assert := assert.New(t)
+	res := httptest.NewRecorder()
+	handler := http.HandlerFunc(ServeHTTP)
+	req, err := http.NewRequest("POST", "", bytes.NewBufferString("{}"))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/yaml")
+	handler.ServeHTTP(res, req)
+	assert.Equal(http.StatusUnsupportedMediaType, res.Code)
+	assert.Equal("unsupported content type application/yaml, only application/json is supported\n",
+		res.Body.String())
+}
+
+func TestServeHTTPUnsupportedVersion(t *testing.T) {
+	assert := assert.New(t)
+	res := httptest.NewRecorder()
+	handler := http.HandlerFunc(ServeHTTP)
+	req, err := http.NewRequest("POST", "", bytes.NewBufferString(`{"kind": "AdmissionReview", "apiVersion": "admission.k8s.io/v2"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	handler.ServeHTTP(res, req)
+	assert.Equal(http.StatusBadRequest, res.Code)
+	assert.Equal("unsupported AdmissionReview version admission.k8s.io/v2\n", res.Body.String())
+}
+
 func TestServeHTTPSubmissions(t *testing.T) {
 	for _, apiVersion := range []string{
 		"admission.k8s.io/v1",
-		"admission.k8s.io/v1",
+		"admission.k8s.io/v1beta1",
 	} {
 		for _, tt := range []struct {
 			name    string
@@ -121,6 +149,15 @@ func TestServeHTTPSubmissions(t *testing.T) {
 				wantRespCode:       http.StatusBadRequest,
 				wantFailureMessage: "submitted object is not of kind AdmissionReview\n",
 			},
+			{
+				name: "AdmissionReview without request",
+				reqBody: dedent.Dedent(`{
+						"kind": "AdmissionReview",
+						"apiVersion": "` + apiVersion + `"
+					}`),
+				wantRespCode:       http.StatusBadRequest,
+				wantFailureMessage: "admission review request is missing\n",
+			},
 			{
 				name: "valid v1 Gateway resource",
 				reqBody: dedent.Dedent(`{
@@ -433,6 +470,227 @@ func TestServeHTTPSubmissions(t *testing.T) {
 					},
 				},
 			},
+			{
+				name: "valid v1a2 GRPCRoute resource",
+				reqBody: dedent.Dedent(`{
+						"kind": "AdmissionReview",
+						"apiVersion": "` + apiVersion + `",
+						"request": {
+							"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+							"resource": {
+								"group": "gateway.networking.k8s.io",
+								"version": "v1alpha2",
+								"resource": "grpcroutes"
+							},
+							"object": {
+								"kind": "GRPCRoute",
+								"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+								"metadata": {
+									"name": "grpc-app-1"
+								},
+								"spec": {
+									"parentRefs": [
+										{"name": "gateway", "sectionName": "grpc"},
+										{"name": "gateway", "sectionName": "grpcs"}
+									],
+									"rules": [
+										{
+											"matches": [{"method": {"service": "foo.Bar", "method": "Login"}}],
+											"filters": [
+												{
+													"type": "RequestMirror",
+													"requestMirror": {"backendRef": {"name": "mirror", "port": 8080}}
+												}
+											],
+											"backendRefs": [{"name": "backend", "port": 8080}]
+										}
+									]
+								}
+							},
+						"operation": "CREATE"
+						}
+					}`),
+				wantRespCode: http.StatusOK,
+				wantSuccessResponse: admission.AdmissionResponse{
+					UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+					Allowed: true,
+					Result:  &metav1.Status{},
+				},
+			},
+			{
+				name: "v1a2 GRPCRoute with the same parentRef twice",
+				reqBody: dedent.Dedent(`{
+						"kind": "AdmissionReview",
+						"apiVersion": "` + apiVersion + `",
+						"request": {
+							"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+							"resource": {
+								"group": "gateway.networking.k8s.io",
+								"version": "v1alpha2",
+								"resource": "grpcroutes"
+							},
+							"object": {
+								"kind": "GRPCRoute",
+								"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+								"metadata": {
+									"name": "grpc-app-1"
+								},
+								"spec": {
+									"parentRefs": [
+										{"name": "gateway"},
+										{"name": "gateway"}
+									],
+									"rules": [
+										{
+											"backendRefs": [{"name": "backend", "port": 8080}]
+										}
+									]
+								}
+							},
+						"operation": "CREATE"
+						}
+					}`),
+				wantRespCode: http.StatusOK,
+				wantSuccessResponse: admission.AdmissionResponse{
+					UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+					Allowed: false,
+					Result: &metav1.Status{
+						Code:    400,
+						Message: `spec.parentRefs: Required value: sectionNames or ports must be specified when more than one parentRef refers to the same parent`,
+					},
+				},
+			},
+			{
+				name: "v1a2 GRPCRoute with a Service backendRef without port",
+				reqBody: dedent.Dedent(`{
+						"kind": "AdmissionReview",
+						"apiVersion": "` + apiVersion + `",
+						"request": {
+							"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+							"resource": {
+								"group": "gateway.networking.k8s.io",
+								"version": "v1alpha2",
+								"resource": "grpcroutes"
+							},
+							"object": {
+								"kind": "GRPCRoute",
+								"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+								"metadata": {
+									"name": "grpc-app-1"
+								},
+								"spec": {
+									"rules": [
+										{
+											"backendRefs": [{"name": "backend"}]
+										}
+									]
+								}
+							},
+						"operation": "CREATE"
+						}
+					}`),
+				wantRespCode: http.StatusOK,
+				wantSuccessResponse: admission.AdmissionResponse{
+					UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+					Allowed: false,
+					Result: &metav1.Status{
+						Code:    400,
+						Message: `spec.rules[0].backendRefs[0].port: Required value: missing port for Service reference`,
+					},
+				},
+			},
+			{
+				name: "v1a2 GRPCRoute with the same match in two rules",
+				reqBody: dedent.Dedent(`{
+						"kind": "AdmissionReview",
+						"apiVersion": "` + apiVersion + `",
+						"request": {
+							"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+							"resource": {
+								"group": "gateway.networking.k8s.io",
+								"version": "v1alpha2",
+								"resource": "grpcroutes"
+							},
+							"object": {
+								"kind": "GRPCRoute",
+								"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+								"metadata": {
+									"name": "grpc-app-1"
+								},
+								"spec": {
+									"rules": [
+										{
+											"matches": [{"method": {"service": "foo.Bar"}}],
+											"backendRefs": [{"name": "backend-v1", "port": 8080}]
+										},
+										{
+											"matches": [{"method": {"type": "Exact", "service": "foo.Bar"}}],
+											"backendRefs": [{"name": "backend-v2", "port": 8080}]
+										}
+									]
+								}
+							},
+						"operation": "CREATE"
+						}
+					}`),
+				wantRespCode: http.StatusOK,
+				wantSuccessResponse: admission.AdmissionResponse{
+					UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+					Allowed: false,
+					Result: &metav1.Status{
+						Code:    400,
+						Message: `spec.rules[1].matches[0]: Invalid value: "method Exact foo.Bar/*": must not be the same match as spec.rules[0].matches[0]`,
+					},
+				},
+			},
+			{
+				name: "v1 Gateway with conflicted listeners",
+				reqBody: dedent.Dedent(`{
+						"kind": "AdmissionReview",
+						"apiVersion": "` + apiVersion + `",
+						"request": {
+							"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+							"resource": {
+								"group": "gateway.networking.k8s.io",
+								"version": "v1",
+								"resource": "gateways"
+							},
+							"object": {
+								"kind": "Gateway",
+								"apiVersion": "gateway.networking.k8s.io/v1",
+								"metadata": {
+									"name": "gateway-1"
+								},
+								"spec": {
+									"gatewayClassName": "contour-class",
+									"listeners": [
+										{
+											"name": "http",
+											"port": 80,
+											"protocol": "HTTP"
+										},
+										{
+											"name": "tcp",
+											"port": 80,
+											"protocol": "TCP"
+										}
+									]
+								}
+							},
+						"operation": "CREATE"
+						}
+					}`),
+				wantRespCode: http.StatusOK,
+				wantSuccessResponse: admission.AdmissionResponse{
+					UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+					Allowed: true,
+					Result:  &metav1.Status{},
+					Warnings: []string{
+						`spec.listeners[0]: ProtocolConflict: protocol conflicts with Listeners "tcp" on the same port`,
+						`spec.listeners[1]: ProtocolConflict: protocol conflicts with Listeners "http" on the same port`,
+					},
+				},
+			},
 			{
 				name: "unknown resource under networking.x-k8s.io",
 				reqBody: dedent.Dedent(`{
@@ -474,6 +732,8 @@ func TestServeHTTPSubmissions(t *testing.T) {
 					var review admission.AdmissionReview
 					_, _, err = decoder.Decode(res.Body.Bytes(), nil, &review)
 					require.NoError(t, err)
+					assert.Equal(apiVersion, review.APIVersion)
+					assert.Equal("application/json", res.Header().Get("Content-Type"))
 					assert.EqualValues(&tt.wantSuccessResponse, review.Response)
 				} else {
 					assert.Equal(res.Body.String(), tt.wantFailureMessage)
@@ -482,3 +742,267 @@ func TestServeHTTPSubmissions(t *testing.T) {
 		}
 	}
 }
+
+func TestServeHTTPMetrics(t *testing.T) {
+	reqBody := dedent.Dedent(`{
+			"kind": "AdmissionReview",
+			"apiVersion": "admission.k8s.io/v1",
+			"request": {
+				"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+				"resource": {
+					"group": "gateway.networking.k8s.io",
+					"version": "v1",
+					"resource": "gatewayclasses"
+				},
+				"object": {
+					"kind": "GatewayClass",
+					"apiVersion": "gateway.networking.k8s.io/v1",
+					"metadata": {
+					   "name": "gateway-class-1"
+					},
+					"spec": {
+					   "controllerName": "example.com/foo"
+					}
+				},
+				"oldObject": {
+					"kind": "GatewayClass",
+					"apiVersion": "gateway.networking.k8s.io/v1",
+					"metadata": {
+					   "name": "gateway-class-1"
+					},
+					"spec": {
+					   "controllerName": "example.com/bar"
+					}
+				},
+			"operation": "UPDATE"
+			}
+		}`)
+	denied := requestsTotal.WithLabelValues("gateway.networking.k8s.io", "v1", "gatewayclasses", "UPDATE", resultDenied)
+	malformed := decodeFailuresTotal.WithLabelValues("", "", "")
+	deniedBefore := testutil.ToFloat64(denied)
+	malformedBefore := testutil.ToFloat64(malformed)
+
+	for _, body := range []string{reqBody, "{"} {
+		res := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", "", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		http.HandlerFunc(ServeHTTP).ServeHTTP(res, req)
+	}
+
+	assert.Equal(t, deniedBefore+1, testutil.ToFloat64(denied))
+	assert.Equal(t, malformedBefore+1, testutil.ToFloat64(malformed))
+
+	res := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	require.NoError(t, err)
+	MetricsHandler().ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Contains(t, res.Body.String(), "gateway_api_admission_request_duration_seconds_bucket")
+}
+
+func TestServeHTTPPolicy(t *testing.T) {
+	p, err := policy.Parse([]byte(dedent.Dedent(`
+		rules:
+		- name: internal-only
+		  namespaces: ["team-a"]
+		  allowedGatewayClasses: ["internal"]
+		- name: web-ports
+		  enforcement: Warn
+		  allowedListenerPorts: [80, 443]
+		`)))
+	require.NoError(t, err)
+	SetPolicy(p)
+	defer SetPolicy(nil)
+
+	for _, tt := range []struct {
+		name      string
+		namespace string
+		className string
+		protocol  string
+
+		wantResponse admission.AdmissionResponse
+	}{
+		{
+			name:      "rule scoped to another namespace only warns",
+			namespace: "team-b",
+			className: "public",
+			wantResponse: admission.AdmissionResponse{
+				UID:      "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+				Allowed:  true,
+				Result:   &metav1.Status{},
+				Warnings: []string{`spec.listeners[0].port: Unsupported value: 8080: supported values: "80", "443" (policy rule "web-ports")`},
+			},
+		},
+		{
+			name:      "denied by policy",
+			namespace: "team-a",
+			className: "public",
+			wantResponse: admission.AdmissionResponse{
+				UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+				Allowed: false,
+				Result: &metav1.Status{
+					Code:    400,
+					Message: `spec.gatewayClassName: Unsupported value: "public": supported values: "internal" (policy rule "internal-only")`,
+				},
+				Warnings: []string{`spec.listeners[0].port: Unsupported value: 8080: supported values: "80", "443" (policy rule "web-ports")`},
+			},
+		},
+		{
+			name:      "policy rules are not reported for invalid objects",
+			namespace: "team-a",
+			className: "public",
+			protocol:  "HTTPS",
+			wantResponse: admission.AdmissionResponse{
+				UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+				Allowed: false,
+				Result: &metav1.Status{
+					Code:    400,
+					Message: "spec.listeners[0].tls: Forbidden: must be set for protocol HTTPS",
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.protocol == "" {
+				tt.protocol = "HTTP"
+			}
+			reqBody := dedent.Dedent(`{
+					"kind": "AdmissionReview",
+					"apiVersion": "admission.k8s.io/v1",
+					"request": {
+						"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+						"namespace": "` + tt.namespace + `",
+						"resource": {
+							"group": "gateway.networking.k8s.io",
+							"version": "v1",
+							"resource": "gateways"
+						},
+						"object": {
+							"kind": "Gateway",
+							"apiVersion": "gateway.networking.k8s.io/v1",
+							"metadata": {
+							   "name": "gateway-1"
+							},
+							"spec": {
+								"gatewayClassName": "` + tt.className + `",
+								"listeners": [
+									{
+										"name": "http",
+										"port": 8080,
+										"protocol": "` + tt.protocol + `"
+									}
+								]
+							}
+						},
+					"operation": "CREATE"
+					}
+				}`)
+			res := httptest.NewRecorder()
+			req, err := http.NewRequest("POST", "", bytes.NewBufferString(reqBody))
+			require.NoError(t, err)
+			http.HandlerFunc(ServeHTTP).ServeHTTP(res, req)
+
+			require.Equal(t, http.StatusOK, res.Code)
+			var review admission.AdmissionReview
+			_, _, err = decoder.Decode(res.Body.Bytes(), nil, &review)
+			require.NoError(t, err)
+			assert.EqualValues(t, &tt.wantResponse, review.Response)
+		})
+	}
+}
+
+func TestServeHTTPRegularExpressions(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		enabled bool
+		pattern string
+
+		wantResponse admission.AdmissionResponse
+	}{
+		{
+			name:    "disabled",
+			pattern: `/foo/(?=bar)`,
+			wantResponse: admission.AdmissionResponse{
+				UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+				Allowed: true,
+				Result:  &metav1.Status{},
+			},
+		},
+		{
+			name:    "unsupported construct",
+			enabled: true,
+			pattern: `/foo/(?=bar)`,
+			wantResponse: admission.AdmissionResponse{
+				UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+				Allowed: false,
+				Result: &metav1.Status{
+					Code:    400,
+					Message: "spec.rules[0].matches[0].path.value: Invalid value: \"/foo/(?=bar)\": must be a valid RE2 regular expression: error parsing regexp: invalid or unsupported Perl syntax: `(?=`",
+				},
+			},
+		},
+		{
+			name:    "unanchored expression",
+			enabled: true,
+			pattern: `/foo/.*`,
+			wantResponse: admission.AdmissionResponse{
+				UID:      "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+				Allowed:  true,
+				Result:   &metav1.Status{},
+				Warnings: []string{`spec.rules[0].matches[0].path.value: regular expression "/foo/.*" is not anchored with ^ and $, implementations may match it against a substring of the value`},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			SetRegularExpressionValidation(tt.enabled)
+			defer SetRegularExpressionValidation(false)
+
+			reqBody := dedent.Dedent(`{
+					"kind": "AdmissionReview",
+					"apiVersion": "admission.k8s.io/v1",
+					"request": {
+						"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+						"resource": {
+							"group": "gateway.networking.k8s.io",
+							"version": "v1",
+							"resource": "httproutes"
+						},
+						"object": {
+							"kind": "HTTPRoute",
+							"apiVersion": "gateway.networking.k8s.io/v1",
+							"metadata": {
+							   "name": "http-app-1"
+							},
+							"spec": {
+								"rules": [
+									{
+										"matches": [
+											{
+												"path": {
+													"type": "RegularExpression",
+													"value": "` + tt.pattern + `"
+												}
+											}
+										]
+									}
+								]
+							}
+						},
+					"operation": "CREATE"
+					}
+				}`)
+			res := httptest.NewRecorder()
+			req, err := http.NewRequest("POST", "", bytes.NewBufferString(reqBody))
+			require.NoError(t, err)
+			http.HandlerFunc(ServeHTTP).ServeHTTP(res, req)
+
+			require.Equal(t, http.StatusOK, res.Code)
+			var review admission.AdmissionReview
+			_, _, err = decoder.Decode(res.Body.Bytes(), nil, &review)
+			require.NoError(t, err)
+			assert.EqualValues(t, &tt.wantResponse, review.Response)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	admission "k8s.io/api/admission/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ErrUnsupportedKind is returned by ValidateObject for objects the webhook
+// does not validate.
+var ErrUnsupportedKind = errors.New("kind is not validated by the admission webhook")
+
+// validatedKinds maps the kinds validated by the webhook to their resources.
+var validatedKinds = map[schema.GroupVersionKind]meta.GroupVersionResource{
+	gvk(v1a2TCPRouteGVP, "TCPRoute"):         v1a2TCPRouteGVP,
+	gvk(v1a2UDPRouteGVP, "UDPRoute"):         v1a2UDPRouteGVP,
+	gvk(v1a2TLSRouteGVP, "TLSRoute"):         v1a2TLSRouteGVP,
+	gvk(v1a2GRPCRouteGVR, "GRPCRoute"):       v1a2GRPCRouteGVR,
+	gvk(v1b1HTTPRouteGVR, "HTTPRoute"):       v1b1HTTPRouteGVR,
+	gvk(v1b1GatewayGVR, "Gateway"):           v1b1GatewayGVR,
+	gvk(v1b1GatewayClassGVR, "GatewayClass"): v1b1GatewayClassGVR,
+	gvk(v1HTTPRouteGVR, "HTTPRoute"):         v1HTTPRouteGVR,
+	gvk(v1GatewayGVR, "Gateway"):             v1GatewayGVR,
+	gvk(v1GatewayClassGVR, "GatewayClass"):   v1GatewayClassGVR,
+}
+
+func gvk(gvr meta.GroupVersionResource, kind string) schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: gvr.Group, Version: gvr.Version, Kind: kind}
+}
+
+// ValidateObject validates the JSON representation of a Gateway API object
+// the same way the webhook validates its creation, including the policy
+// rules configured with SetPolicy. It returns the validation errors and the
+// warnings of the policy rules. ErrUnsupportedKind is returned for objects
+// the webhook does not validate.
+func ValidateObject(raw []byte) (field.ErrorList, []string, error) {
+	var obj meta.PartialObjectMetadata
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, nil, err
+	}
+	kind := obj.GroupVersionKind()
+	resource, ok := validatedKinds[kind]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedKind, kind)
+	}
+	return validateRequest(admission.AdmissionRequest{
+		Kind:      meta.GroupVersionKind{Group: kind.Group, Version: kind.Version, Kind: kind.Kind},
+		Resource:  resource,
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+		Operation: admission.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	})
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consts contains constants shared by the CRD generator and the
+// conformance test suite.
+package consts
+
+const (
+	// BundleVersionAnnotation is the annotation set on the CRDs to the
+	// version of the Gateway API bundle they are part of.
+	BundleVersionAnnotation = "gateway.networking.k8s.io/bundle-version"
+
+	// ChannelAnnotation is the annotation set on the CRDs to the release
+	// channel they are part of.
+	ChannelAnnotation = "gateway.networking.k8s.io/channel"
+
+	// These values must be updated during the release process
+
+	// BundleVersion is the version of the Gateway API bundle.
+	BundleVersion = "v1.0.0-rc2"
+
+	// ApprovalLink is the API review URL set as the
+	// api-approved.kubernetes.io annotation of the CRDs.
+	ApprovalLink = "https://github.com/kubernetes-sigs/gateway-api/pull/2466"
+)
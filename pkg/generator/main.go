@@ -28,15 +28,8 @@ import (
 	"sigs.k8s.io/controller-tools/pkg/loader"
 	"sigs.k8s.io/controller-tools/pkg/markers"
 	"sigs.k8s.io/yaml"
-)
-
-const (
-	bundleVersionAnnotation = "gateway.networking.k8s.io/bundle-version"
-	channelAnnotation       = "gateway.networking.k8s.io/channel"
 
-	// These values must be updated during the release process
-	bundleVersion = "v1.0.0-rc2"
-	approvalLink  = "https://github.com/kubernetes-sigs/gateway-api/pull/2466"
+	"sigs.k8s.io/gateway-api/pkg/consts"
 )
 
 var standardKinds = map[string]bool{
@@ -104,9 +97,9 @@ func main() {
 			if crdRaw.ObjectMeta.Annotations == nil {
 				crdRaw.ObjectMeta.Annotations = map[string]string{}
 			}
-			crdRaw.ObjectMeta.Annotations[bundleVersionAnnotation] = bundleVersion
-			crdRaw.ObjectMeta.Annotations[channelAnnotation] = channel
-			crdRaw.ObjectMeta.Annotations[apiext.KubeAPIApprovedAnnotation] = approvalLink
+			crdRaw.ObjectMeta.Annotations[consts.BundleVersionAnnotation] = consts.BundleVersion
+			crdRaw.ObjectMeta.Annotations[consts.ChannelAnnotation] = channel
+			crdRaw.ObjectMeta.Annotations[apiext.KubeAPIApprovedAnnotation] = consts.ApprovalLink
 
 			// Prevent the top level metadata for the CRD to be generated regardless of the intention in the arguments
 			crd.FixTopLevelMetadata(crdRaw)